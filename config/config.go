@@ -10,12 +10,16 @@ import (
 
 type (
 	Config struct {
-		App      App
-		Db       Db
-		Jwt      Jwt
-		Kafka    Kafka
-		Grpc     Grpc
-		Paginate Paginate
+		App       App
+		Db        Db
+		Jwt       Jwt
+		Kafka     Kafka
+		Grpc      Grpc
+		Paginate  Paginate
+		Blob      Blob
+		ItemCache ItemCache
+		Inventory Inventory
+		Player    Player
 	}
 
 	App struct {
@@ -50,6 +54,26 @@ type (
 		PaymentUrl   string
 	}
 
+	Blob struct {
+		Driver    string
+		LocalDir  string
+		PublicUrl string
+	}
+
+	ItemCache struct {
+		Ttl        int64
+		Size       int
+		Invalidate bool
+	}
+
+	Inventory struct {
+		BaseCapacity int64
+	}
+
+	Player struct {
+		ShowcaseSize int
+	}
+
 	Paginate struct {
 		ItemNextPageBasedUrl      string
 		InventoryNextPageBasedUrl string
@@ -105,5 +129,52 @@ func LoadConfig(path string) Config {
 			ItemNextPageBasedUrl:      os.Getenv("PAGINATE_ITEM_NEXT_PAGE_BASED_URL"),
 			InventoryNextPageBasedUrl: os.Getenv("PAGINATE_INVENTORY_NEXT_PAGE_BASED_URL"),
 		},
+		Blob: Blob{
+			Driver:    getEnvOrDefault("BLOB_DRIVER", "local"),
+			LocalDir:  getEnvOrDefault("BLOB_LOCAL_DIR", "./uploads"),
+			PublicUrl: getEnvOrDefault("BLOB_PUBLIC_URL", "/item_v1/images"),
+		},
+		ItemCache: ItemCache{
+			Ttl: func() int64 {
+				result, err := strconv.ParseInt(getEnvOrDefault("ITEM_CACHE_TTL", "60"), 10, 64)
+				if err != nil {
+					log.Fatal("Error loading item cache ttl failed")
+				}
+				return result
+			}(),
+			Size: func() int {
+				result, err := strconv.Atoi(getEnvOrDefault("ITEM_CACHE_SIZE", "1000"))
+				if err != nil {
+					log.Fatal("Error loading item cache size failed")
+				}
+				return result
+			}(),
+			Invalidate: os.Getenv("ITEM_CACHE_INVALIDATE") == "true",
+		},
+		Inventory: Inventory{
+			BaseCapacity: func() int64 {
+				result, err := strconv.ParseInt(getEnvOrDefault("INVENTORY_BASE_CAPACITY", "50"), 10, 64)
+				if err != nil {
+					log.Fatal("Error loading inventory base capacity failed")
+				}
+				return result
+			}(),
+		},
+		Player: Player{
+			ShowcaseSize: func() int {
+				result, err := strconv.Atoi(getEnvOrDefault("PLAYER_SHOWCASE_SIZE", "6"))
+				if err != nil {
+					log.Fatal("Error loading player showcase size failed")
+				}
+				return result
+			}(),
+		},
+	}
+}
+
+func getEnvOrDefault(key, fallback string) string {
+	if v := os.Getenv(key); v != "" {
+		return v
 	}
+	return fallback
 }
@@ -1,11 +1,139 @@
 package inventory
 
-import "go.mongodb.org/mongo-driver/bson/primitive"
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
 
 type (
 	Inventory struct {
-		Id       primitive.ObjectID `json:"_id" bson:"_id,omitempty"`
-		PlayerId string             `json:"player_id" bson:"player_id"`
-		ItemId   string             `json:"item_id" bson:"item_id"`
+		Id           primitive.ObjectID `json:"_id" bson:"_id,omitempty"`
+		PlayerId     string             `json:"player_id" bson:"player_id"`
+		ItemId       string             `json:"item_id" bson:"item_id"`
+		Quantity     int64              `json:"quantity" bson:"quantity"`
+		LockedBy     string             `json:"locked_by,omitempty" bson:"locked_by,omitempty"`
+		EquippedSlot string             `json:"equipped_slot,omitempty" bson:"equipped_slot,omitempty"`
+		ExpiresAt    *time.Time         `json:"expires_at,omitempty" bson:"expires_at,omitempty"`
+		Item         *ItemSnapshot      `json:"item,omitempty" bson:"item,omitempty"`
+		UpgradeLevel int                `json:"upgrade_level,omitempty" bson:"upgrade_level,omitempty"`
+		BonusDamage  int                `json:"bonus_damage,omitempty" bson:"bonus_damage,omitempty"`
+		SplitFrom    string             `json:"-" bson:"split_from,omitempty"`
+	}
+
+	// ItemSnapshot copies the item fields the inventory is searched and sorted by, Price is the
+	// effective price like the item service reports it, Version is the item version it was taken
+	// from so older copies can be told apart
+	ItemSnapshot struct {
+		Title     string    `json:"title" bson:"title"`
+		Category  string    `json:"category,omitempty" bson:"category,omitempty"`
+		Rarity    string    `json:"rarity,omitempty" bson:"rarity,omitempty"`
+		Price     float64   `json:"price" bson:"price"`
+		Damage    int       `json:"damage" bson:"damage"`
+		ImageUrl  string    `json:"image_url" bson:"image_url"`
+		EquipType string    `json:"equip_type,omitempty" bson:"equip_type,omitempty"`
+		Version   int64     `json:"version" bson:"version"`
+		SyncedAt  time.Time `json:"synced_at" bson:"synced_at"`
+	}
+
+	// InventoryEvent is an append-only record of one change to a player's items, Quantity is
+	// signed so summing a player's events up to a moment gives what they held at that moment.
+	// Entry keeps a removed entry as it was, so a rollback puts back the same entry with its
+	// upgrade and expiry instead of a fresh unit
+	InventoryEvent struct {
+		EventId      string     `json:"event_id" bson:"_id"`
+		Type         string     `json:"type" bson:"type"`
+		PlayerId     string     `json:"player_id" bson:"player_id"`
+		InventoryId  string     `json:"inventory_id" bson:"inventory_id"`
+		ItemId       string     `json:"item_id" bson:"item_id"`
+		Quantity     int64      `json:"quantity" bson:"quantity"`
+		EquippedSlot string     `json:"equipped_slot,omitempty" bson:"equipped_slot,omitempty"`
+		Source       string     `json:"source" bson:"source"`
+		SourceId     string     `json:"source_id,omitempty" bson:"source_id,omitempty"`
+		Reason       string     `json:"reason,omitempty" bson:"reason,omitempty"`
+		Entry        *Inventory `json:"-" bson:"entry,omitempty"`
+		OccurredAt   time.Time  `json:"occurred_at" bson:"occurred_at"`
+	}
+
+	// AdminJob is a batch grant run by the worker, Cursor is the last player handled so a job
+	// picked up again after a crash carries on where it stopped. Lease is handed out with every
+	// claim and fences the job's writes, a worker whose job was taken over stops at its next write
+	AdminJob struct {
+		Id          primitive.ObjectID `json:"_id" bson:"_id,omitempty"`
+		Type        string             `json:"type" bson:"type"`
+		AdminId     string             `json:"admin_id" bson:"admin_id"`
+		OwnerItemId string             `json:"owner_item_id" bson:"owner_item_id"`
+		ItemId      string             `json:"item_id" bson:"item_id"`
+		Quantity    int                `json:"quantity" bson:"quantity"`
+		Reason      string             `json:"reason" bson:"reason"`
+		Status      string             `json:"status" bson:"status"`
+		Total       int64              `json:"total" bson:"total"`
+		Processed   int64              `json:"processed" bson:"processed"`
+		Succeeded   int64              `json:"succeeded" bson:"succeeded"`
+		Failed      int64              `json:"failed" bson:"failed"`
+		Errors      []string           `json:"errors" bson:"errors"`
+		Cursor      string             `json:"-" bson:"cursor"`
+		Lease       string             `json:"-" bson:"lease,omitempty"`
+		CreatedAt   time.Time          `json:"created_at" bson:"created_at"`
+		UpdatedAt   time.Time          `json:"updated_at" bson:"updated_at"`
+		FinishedAt  *time.Time         `json:"finished_at,omitempty" bson:"finished_at,omitempty"`
+	}
+
+	// Craft is the saga of one craft, Status is the stage it reached: consuming, charging,
+	// granting, then completed, or compensating then failed. Consumed and Granted grow with every
+	// unit moved and shrink again while compensating, so a craft whose worker stopped is finished
+	// or undone by the recovery worker from where it was left
+	Craft struct {
+		Id             primitive.ObjectID `json:"_id" bson:"_id,omitempty"`
+		PlayerId       string             `json:"player_id" bson:"player_id"`
+		RecipeId       string             `json:"recipe_id" bson:"recipe_id"`
+		OutputItemId   string             `json:"output_item_id" bson:"output_item_id"`
+		OutputQuantity int                `json:"output_quantity" bson:"output_quantity"`
+		Status         string             `json:"status" bson:"status"`
+		Consumed       []string           `json:"consumed" bson:"consumed"`
+		TransactionId  string             `json:"transaction_id,omitempty" bson:"transaction_id,omitempty"`
+		Granted        []string           `json:"granted" bson:"granted"`
+		CreatedAt      time.Time          `json:"created_at" bson:"created_at"`
+		UpdatedAt      time.Time          `json:"updated_at" bson:"updated_at"`
+	}
+
+	InventoryCapacity struct {
+		PlayerId  string    `json:"player_id" bson:"player_id"`
+		Capacity  int64     `json:"capacity" bson:"capacity"`
+		UpdatedAt time.Time `json:"updated_at" bson:"updated_at"`
+	}
+
+	InventoryExpansion struct {
+		Id        primitive.ObjectID `json:"_id" bson:"_id,omitempty"`
+		PlayerId  string             `json:"player_id" bson:"player_id"`
+		ItemId    string             `json:"item_id" bson:"item_id"`
+		Slots     int64              `json:"slots" bson:"slots"`
+		CreatedAt time.Time          `json:"created_at" bson:"created_at"`
+	}
+
+	// Trade goes from pending to processing once both sides confirm, and to settling once every
+	// item has moved. TransactionIds are the coin transactions made on the way, kept so a trade
+	// whose worker stopped can still be rolled back
+	Trade struct {
+		Id             primitive.ObjectID `json:"_id" bson:"_id,omitempty"`
+		Initiator      TradeOffer         `json:"initiator" bson:"initiator"`
+		Counterparty   TradeOffer         `json:"counterparty" bson:"counterparty"`
+		Status         string             `json:"status" bson:"status"`
+		TransactionIds []string           `json:"-" bson:"transaction_ids,omitempty"`
+		ExpiresAt      time.Time          `json:"expires_at" bson:"expires_at"`
+		CreatedAt      time.Time          `json:"created_at" bson:"created_at"`
+		UpdatedAt      time.Time          `json:"updated_at" bson:"updated_at"`
+	}
+
+	TradeOffer struct {
+		PlayerId  string            `json:"player_id" bson:"player_id"`
+		Items     []*TradeOfferItem `json:"items" bson:"items"`
+		Coins     float64           `json:"coins" bson:"coins"`
+		Confirmed bool              `json:"confirmed" bson:"confirmed"`
+	}
+
+	TradeOfferItem struct {
+		InventoryId string `json:"inventory_id" bson:"inventory_id"`
+		ItemId      string `json:"item_id" bson:"item_id"`
 	}
 )
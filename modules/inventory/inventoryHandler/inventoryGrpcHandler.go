@@ -0,0 +1,48 @@
+package inventoryHandler
+
+import (
+	"context"
+
+	"github.com/Applessr/hello-sekai-shop-tutorial/config"
+	inventoryPb "github.com/Applessr/hello-sekai-shop-tutorial/modules/inventory/inventoryPb"
+	"github.com/Applessr/hello-sekai-shop-tutorial/modules/inventory/inventoryUsecase"
+)
+
+type (
+	inventoryGrpcHandler struct {
+		inventoryPb.UnimplementedInventoryGrpcServiceServer
+		cfg              *config.Config
+		inventoryUsecase inventoryUsecase.InventoryUsecaseService
+	}
+)
+
+func NewInventoryGrpcHandler(cfg *config.Config, inventoryUsecase inventoryUsecase.InventoryUsecaseService) *inventoryGrpcHandler {
+	return &inventoryGrpcHandler{
+		cfg:              cfg,
+		inventoryUsecase: inventoryUsecase,
+	}
+}
+
+func (g *inventoryGrpcHandler) HasItems(ctx context.Context, req *inventoryPb.HasItemsReq) (*inventoryPb.HasItemsRes, error) {
+	return g.inventoryUsecase.HasItems(ctx, req)
+}
+
+func (g *inventoryGrpcHandler) CountPlayerItems(ctx context.Context, req *inventoryPb.CountPlayerItemsReq) (*inventoryPb.CountPlayerItemsRes, error) {
+	return g.inventoryUsecase.CountPlayerItems(ctx, req)
+}
+
+func (g *inventoryGrpcHandler) ListPlayerItems(ctx context.Context, req *inventoryPb.ListPlayerItemsReq) (*inventoryPb.ListPlayerItemsRes, error) {
+	return g.inventoryUsecase.ListPlayerItems(ctx, req)
+}
+
+func (g *inventoryGrpcHandler) GetInventoryEntry(ctx context.Context, req *inventoryPb.GetInventoryEntryReq) (*inventoryPb.InventoryEntry, error) {
+	return g.inventoryUsecase.GetInventoryEntry(ctx, req)
+}
+
+func (g *inventoryGrpcHandler) GetInventoryEntries(ctx context.Context, req *inventoryPb.GetInventoryEntriesReq) (*inventoryPb.GetInventoryEntriesRes, error) {
+	return g.inventoryUsecase.GetInventoryEntries(ctx, req)
+}
+
+func (g *inventoryGrpcHandler) GetLoadout(ctx context.Context, req *inventoryPb.GetLoadoutReq) (*inventoryPb.Loadout, error) {
+	return g.inventoryUsecase.GetLoadout(ctx, g.cfg, req)
+}
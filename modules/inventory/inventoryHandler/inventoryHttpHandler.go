@@ -15,6 +15,24 @@ import (
 type (
 	InventoryHttpHandlerService interface {
 		FindPlayerItems(c echo.Context) error
+		FindPlayerCapacity(c echo.Context) error
+		FindPlayerItemHistory(c echo.Context) error
+		RebuildPlayerInventory(c echo.Context) error
+		AdminGrantItems(c echo.Context) error
+		AdminRevokeItems(c echo.Context) error
+		AdminTransferItem(c echo.Context) error
+		CreateBatchGrant(c echo.Context) error
+		FindAdminJob(c echo.Context) error
+		FindLoadout(c echo.Context) error
+		EquipItem(c echo.Context) error
+		UnequipItem(c echo.Context) error
+		CraftItem(c echo.Context) error
+		UpgradeItem(c echo.Context) error
+		CreateTrade(c echo.Context) error
+		FindOneTrade(c echo.Context) error
+		UpdateTradeOffer(c echo.Context) error
+		ConfirmTrade(c echo.Context) error
+		CancelTrade(c echo.Context) error
 	}
 
 	inventoryHttpHandler struct {
@@ -46,3 +64,323 @@ func (h *inventoryHttpHandler) FindPlayerItems(c echo.Context) error {
 
 	return response.SuccessResponse(c, http.StatusOK, res)
 }
+
+func (h *inventoryHttpHandler) FindPlayerItemHistory(c echo.Context) error {
+	ctx := context.Background()
+
+	wrapper := request.ContextWrapper(c)
+
+	req := new(inventory.InventoryHistoryReq)
+	playerId := c.Param("player_id")
+
+	if err := wrapper.Bind(req); err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	res, err := h.inventoryUsecase.FindPlayerItemHistory(ctx, h.cfg, playerId, req)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, res)
+}
+
+func (h *inventoryHttpHandler) RebuildPlayerInventory(c echo.Context) error {
+	ctx := context.Background()
+
+	wrapper := request.ContextWrapper(c)
+
+	req := new(inventory.InventorySnapshotReq)
+	playerId := c.Param("player_id")
+
+	if err := wrapper.Bind(req); err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	res, err := h.inventoryUsecase.RebuildPlayerInventory(ctx, playerId, req)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, res)
+}
+
+func (h *inventoryHttpHandler) FindPlayerCapacity(c echo.Context) error {
+	ctx := context.Background()
+
+	playerId := c.Param("player_id")
+
+	res, err := h.inventoryUsecase.FindPlayerCapacity(ctx, h.cfg, playerId)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, res)
+}
+
+func (h *inventoryHttpHandler) FindLoadout(c echo.Context) error {
+	ctx := context.Background()
+
+	playerId := c.Param("player_id")
+
+	res, err := h.inventoryUsecase.FindLoadout(ctx, h.cfg, playerId)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, res)
+}
+
+func (h *inventoryHttpHandler) EquipItem(c echo.Context) error {
+	ctx := context.Background()
+
+	wrapper := request.ContextWrapper(c)
+
+	req := new(inventory.EquipItemReq)
+	playerId := c.Get("player_id").(string)
+
+	if err := wrapper.Bind(req); err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	res, err := h.inventoryUsecase.EquipItem(ctx, h.cfg, playerId, req)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, res)
+}
+
+func (h *inventoryHttpHandler) UnequipItem(c echo.Context) error {
+	ctx := context.Background()
+
+	wrapper := request.ContextWrapper(c)
+
+	req := new(inventory.UnequipItemReq)
+	playerId := c.Get("player_id").(string)
+
+	if err := wrapper.Bind(req); err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	res, err := h.inventoryUsecase.UnequipItem(ctx, h.cfg, playerId, req)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, res)
+}
+
+func (h *inventoryHttpHandler) CreateTrade(c echo.Context) error {
+	ctx := context.Background()
+
+	wrapper := request.ContextWrapper(c)
+
+	req := new(inventory.CreateTradeReq)
+	playerId := c.Get("player_id").(string)
+
+	if err := wrapper.Bind(req); err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	res, err := h.inventoryUsecase.CreateTrade(ctx, h.cfg, playerId, req)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusCreated, res)
+}
+
+func (h *inventoryHttpHandler) FindOneTrade(c echo.Context) error {
+	ctx := context.Background()
+
+	playerId := c.Get("player_id").(string)
+	tradeId := c.Param("trade_id")
+
+	res, err := h.inventoryUsecase.FindOneTrade(ctx, playerId, tradeId)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, res)
+}
+
+func (h *inventoryHttpHandler) UpdateTradeOffer(c echo.Context) error {
+	ctx := context.Background()
+
+	wrapper := request.ContextWrapper(c)
+
+	req := &inventory.UpdateTradeOfferReq{
+		ItemIds: make([]string, 0),
+	}
+	playerId := c.Get("player_id").(string)
+	tradeId := c.Param("trade_id")
+
+	if err := wrapper.Bind(req); err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	res, err := h.inventoryUsecase.UpdateTradeOffer(ctx, h.cfg, playerId, tradeId, req)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, res)
+}
+
+func (h *inventoryHttpHandler) ConfirmTrade(c echo.Context) error {
+	ctx := context.Background()
+
+	playerId := c.Get("player_id").(string)
+	tradeId := c.Param("trade_id")
+
+	res, err := h.inventoryUsecase.ConfirmTrade(ctx, h.cfg, playerId, tradeId)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, res)
+}
+
+func (h *inventoryHttpHandler) CancelTrade(c echo.Context) error {
+	ctx := context.Background()
+
+	playerId := c.Get("player_id").(string)
+	tradeId := c.Param("trade_id")
+
+	res, err := h.inventoryUsecase.CancelTrade(ctx, playerId, tradeId)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, res)
+}
+
+func (h *inventoryHttpHandler) AdminGrantItems(c echo.Context) error {
+	ctx := context.Background()
+
+	wrapper := request.ContextWrapper(c)
+
+	req := new(inventory.AdminGrantReq)
+
+	if err := wrapper.Bind(req); err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	res, err := h.inventoryUsecase.AdminGrantItems(ctx, h.cfg, c.Get("player_id").(string), req)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusCreated, res)
+}
+
+func (h *inventoryHttpHandler) AdminRevokeItems(c echo.Context) error {
+	ctx := context.Background()
+
+	wrapper := request.ContextWrapper(c)
+
+	req := new(inventory.AdminRevokeReq)
+
+	if err := wrapper.Bind(req); err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	res, err := h.inventoryUsecase.AdminRevokeItems(ctx, c.Get("player_id").(string), req)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, res)
+}
+
+func (h *inventoryHttpHandler) AdminTransferItem(c echo.Context) error {
+	ctx := context.Background()
+
+	wrapper := request.ContextWrapper(c)
+
+	req := new(inventory.AdminTransferReq)
+
+	if err := wrapper.Bind(req); err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	res, err := h.inventoryUsecase.AdminTransferItem(ctx, h.cfg, c.Get("player_id").(string), req)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, res)
+}
+
+func (h *inventoryHttpHandler) CreateBatchGrant(c echo.Context) error {
+	ctx := context.Background()
+
+	wrapper := request.ContextWrapper(c)
+
+	req := new(inventory.AdminBatchGrantReq)
+
+	if err := wrapper.Bind(req); err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	res, err := h.inventoryUsecase.CreateBatchGrant(ctx, h.cfg, c.Get("player_id").(string), req)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusAccepted, res)
+}
+
+func (h *inventoryHttpHandler) FindAdminJob(c echo.Context) error {
+	ctx := context.Background()
+
+	jobId := c.Param("job_id")
+
+	res, err := h.inventoryUsecase.FindAdminJob(ctx, jobId)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusNotFound, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, res)
+}
+
+func (h *inventoryHttpHandler) CraftItem(c echo.Context) error {
+	ctx := context.Background()
+
+	wrapper := request.ContextWrapper(c)
+
+	req := new(inventory.CraftItemReq)
+	playerId := c.Get("player_id").(string)
+
+	if err := wrapper.Bind(req); err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	res, err := h.inventoryUsecase.CraftItem(ctx, h.cfg, playerId, req)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusCreated, res)
+}
+
+func (h *inventoryHttpHandler) UpgradeItem(c echo.Context) error {
+	ctx := context.Background()
+
+	wrapper := request.ContextWrapper(c)
+
+	req := new(inventory.UpgradeItemReq)
+	playerId := c.Get("player_id").(string)
+
+	if err := wrapper.Bind(req); err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	res, err := h.inventoryUsecase.UpgradeItem(ctx, h.cfg, playerId, req)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, res)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/Applessr/hello-sekai-shop-tutorial/config"
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/inventory"
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/inventory/inventoryUsecase"
+	"github.com/Applessr/hello-sekai-shop-tutorial/modules/item"
 	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/queue"
 	"github.com/IBM/sarama"
 )
@@ -20,6 +21,7 @@ type (
 		RemovePlayerItem()
 		RollbackAddPlayerItem()
 		RollbackRemovePlayerItem()
+		SyncItemSnapshots()
 	}
 
 	inventoryQueueHandler struct {
@@ -215,3 +217,60 @@ func (h *inventoryQueueHandler) RollbackRemovePlayerItem() {
 		}
 	}
 }
+
+// SyncItemSnapshots keeps the item snapshots on inventory entries in line with the item service,
+// events missed while the service is down are caught up when the entries are read
+func (h *inventoryQueueHandler) SyncItemSnapshots() {
+	ctx := context.Background()
+
+	worker, err := queue.ConnectConsumer([]string{h.cfg.Kafka.Url}, h.cfg.Kafka.ApiKey, h.cfg.Kafka.Secret)
+	if err != nil {
+		return
+	}
+	defer worker.Close()
+
+	partitions, err := worker.Partitions("item")
+	if err != nil {
+		log.Println("Error: SyncItemSnapshots failed: ", err.Error())
+		return
+	}
+
+	messages := make(chan *sarama.ConsumerMessage)
+	for _, partition := range partitions {
+		consumer, err := worker.ConsumePartition("item", partition, sarama.OffsetNewest)
+		if err != nil {
+			log.Println("Error: SyncItemSnapshots failed: ", err.Error())
+			return
+		}
+		defer consumer.Close()
+
+		go func() {
+			for msg := range consumer.Messages() {
+				messages <- msg
+			}
+		}()
+	}
+
+	log.Println("Start SyncItemSnapshots ...")
+
+	sigchan := make(chan os.Signal, 1)
+	signal.Notify(sigchan, syscall.SIGINT, syscall.SIGTERM)
+
+	for {
+		select {
+		case msg := <-messages:
+			req := new(item.ItemEvent)
+
+			if err := queue.DecodeMessage(req, msg.Value); err != nil {
+				continue
+			}
+
+			h.inventoryUsecase.SyncItemSnapshot(ctx, req)
+
+			log.Printf("SyncItemSnapshots | %s synced %s v%d", req.Type, req.ItemId, req.Version)
+		case <-sigchan:
+			log.Println("Stop SyncItemSnapshots...")
+			return
+		}
+	}
+}
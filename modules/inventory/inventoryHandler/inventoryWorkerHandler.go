@@ -0,0 +1,142 @@
+package inventoryHandler
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/Applessr/hello-sekai-shop-tutorial/config"
+	"github.com/Applessr/hello-sekai-shop-tutorial/modules/inventory/inventoryUsecase"
+)
+
+type (
+	InventoryWorkerHandlerService interface {
+		ExpireTrades()
+		ExpireItems()
+		RunAdminJobs()
+		RecoverCrafts()
+		RefreshSnapshots()
+	}
+
+	inventoryWorkerHandler struct {
+		cfg              *config.Config
+		inventoryUsecase inventoryUsecase.InventoryUsecaseService
+	}
+)
+
+func NewInventoryWorkerHandler(cfg *config.Config, inventoryUsecase inventoryUsecase.InventoryUsecaseService) InventoryWorkerHandlerService {
+	return &inventoryWorkerHandler{cfg, inventoryUsecase}
+}
+
+func (h *inventoryWorkerHandler) ExpireTrades() {
+	ctx := context.Background()
+
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	log.Println("Start ExpireTrades ...")
+
+	sigchan := make(chan os.Signal, 1)
+	signal.Notify(sigchan, syscall.SIGINT, syscall.SIGTERM)
+
+	for {
+		select {
+		case <-ticker.C:
+			h.inventoryUsecase.ExpireTrades(ctx, h.cfg)
+		case <-sigchan:
+			log.Println("Stop ExpireTrades...")
+			return
+		}
+	}
+}
+
+func (h *inventoryWorkerHandler) ExpireItems() {
+	ctx := context.Background()
+
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	log.Println("Start ExpireItems ...")
+
+	sigchan := make(chan os.Signal, 1)
+	signal.Notify(sigchan, syscall.SIGINT, syscall.SIGTERM)
+
+	for {
+		select {
+		case <-ticker.C:
+			h.inventoryUsecase.ExpireItems(ctx, h.cfg)
+		case <-sigchan:
+			log.Println("Stop ExpireItems...")
+			return
+		}
+	}
+}
+
+func (h *inventoryWorkerHandler) RunAdminJobs() {
+	ctx := context.Background()
+
+	ticker := time.NewTicker(10 * time.Second)
+	defer ticker.Stop()
+
+	log.Println("Start RunAdminJobs ...")
+
+	sigchan := make(chan os.Signal, 1)
+	signal.Notify(sigchan, syscall.SIGINT, syscall.SIGTERM)
+
+	for {
+		select {
+		case <-ticker.C:
+			h.inventoryUsecase.RunAdminJobs(ctx, h.cfg)
+		case <-sigchan:
+			log.Println("Stop RunAdminJobs...")
+			return
+		}
+	}
+}
+
+func (h *inventoryWorkerHandler) RecoverCrafts() {
+	ctx := context.Background()
+
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	log.Println("Start RecoverCrafts ...")
+
+	sigchan := make(chan os.Signal, 1)
+	signal.Notify(sigchan, syscall.SIGINT, syscall.SIGTERM)
+
+	for {
+		select {
+		case <-ticker.C:
+			h.inventoryUsecase.RecoverCrafts(ctx, h.cfg)
+		case <-sigchan:
+			log.Println("Stop RecoverCrafts...")
+			return
+		}
+	}
+}
+
+func (h *inventoryWorkerHandler) RefreshSnapshots() {
+	ctx := context.Background()
+
+	ticker := time.NewTicker(10 * time.Minute)
+	defer ticker.Stop()
+
+	log.Println("Start RefreshSnapshots ...")
+
+	sigchan := make(chan os.Signal, 1)
+	signal.Notify(sigchan, syscall.SIGINT, syscall.SIGTERM)
+
+	for {
+		select {
+		case <-ticker.C:
+			h.inventoryUsecase.RefreshSnapshots(ctx, h.cfg)
+		case <-sigchan:
+			log.Println("Stop RefreshSnapshots...")
+			return
+		}
+	}
+}
@@ -1,23 +1,78 @@
 package inventory
 
 import (
+	"errors"
+	"time"
+
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/item"
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/models"
 )
 
+// ErrInventoryFull is sent back through the saga reply so the buyer sees why the grant failed
+var ErrInventoryFull = errors.New("error: inventory is full")
+
+// EquipmentSlots maps each slot to the equip type an item needs to go there
+var EquipmentSlots = map[string]string{
+	"weapon":      "weapon",
+	"armor":       "armor",
+	"accessory_1": "accessory",
+	"accessory_2": "accessory",
+}
+
 type (
 	UpdateInventoryReq struct {
 		PlayerId string `json:"player_id" validate:"required,max=64"`
 		ItemId   string `json:"item_id" validate:"required,max=64"`
+		SagaId   string `json:"saga_id,omitempty"`
 	}
 
 	ItemInInventory struct {
+		InventoryId  string     `json:"inventory_id"`
+		PlayerId     string     `json:"player_id"`
+		Quantity     int64      `json:"quantity"`
+		EquippedSlot string     `json:"equipped_slot,omitempty"`
+		ExpiresAt    *time.Time `json:"expires_at,omitempty"`
+		UpgradeLevel int        `json:"upgrade_level,omitempty"`
+		Retired      bool       `json:"retired,omitempty"`
+		*item.ItemShowCase
+	}
+
+	InventoryCapacityRes struct {
+		PlayerId string `json:"player_id"`
+		Capacity int64  `json:"capacity"`
+		Used     int64  `json:"used"`
+		Free     int64  `json:"free"`
+	}
+
+	EquipItemReq struct {
+		InventoryId string `json:"inventory_id" validate:"required,max=64"`
+		Slot        string `json:"slot" validate:"required,max=32"`
+	}
+
+	UnequipItemReq struct {
+		Slot string `json:"slot" validate:"required,max=32"`
+	}
+
+	EquippedItem struct {
+		Slot        string `json:"slot"`
 		InventoryId string `json:"inventory_id"`
-		PlayerId    string `json:"player_id"`
+		Retired     bool   `json:"retired,omitempty"`
 		*item.ItemShowCase
 	}
 
+	LoadoutRes struct {
+		PlayerId string          `json:"player_id"`
+		Slots    []*EquippedItem `json:"slots"`
+		Degraded bool            `json:"degraded,omitempty"`
+	}
+
 	InventorySearchReq struct {
+		Title    string `query:"title" validate:"max=64"`
+		Category string `query:"category" validate:"max=32"`
+		Rarity   string `query:"rarity" validate:"omitempty,oneof=common uncommon rare epic legendary"`
+		Equipped string `query:"equipped" validate:"omitempty,oneof=true false"`
+		Sort     string `query:"sort" validate:"omitempty,oneof=acquired price damage"`
+		Order    string `query:"order" validate:"omitempty,oneof=asc desc"`
 		models.PaginateReq
 	}
 
@@ -25,5 +80,104 @@ type (
 		InventoryId string `json:"inventory_id"`
 		PlayerId    string `json:"player_id"`
 		ItemId      string `json:"item_id"`
+		SagaId      string `json:"saga_id,omitempty"`
+	}
+
+	AdminGrantReq struct {
+		PlayerId string `json:"player_id" validate:"required,max=64"`
+		ItemId   string `json:"item_id" validate:"required,max=64"`
+		Quantity int    `json:"quantity" validate:"required,min=1,max=100"`
+		Reason   string `json:"reason" validate:"required,max=256"`
+	}
+
+	AdminRevokeReq struct {
+		PlayerId string `json:"player_id" validate:"required,max=64"`
+		ItemId   string `json:"item_id" validate:"required,max=64"`
+		Quantity int    `json:"quantity" validate:"required,min=1,max=100"`
+		Reason   string `json:"reason" validate:"required,max=256"`
+	}
+
+	AdminTransferReq struct {
+		FromPlayerId string `json:"from_player_id" validate:"required,max=64"`
+		ToPlayerId   string `json:"to_player_id" validate:"required,max=64"`
+		InventoryId  string `json:"inventory_id" validate:"required,max=64"`
+		Reason       string `json:"reason" validate:"required,max=256"`
+	}
+
+	AdminBatchGrantReq struct {
+		OwnerItemId string `json:"owner_item_id" validate:"required,max=64"`
+		ItemId      string `json:"item_id" validate:"required,max=64"`
+		Quantity    int    `json:"quantity" validate:"required,min=1,max=100"`
+		Reason      string `json:"reason" validate:"required,max=256"`
+	}
+
+	AdminActionRes struct {
+		PlayerId     string   `json:"player_id"`
+		ItemId       string   `json:"item_id"`
+		InventoryIds []string `json:"inventory_ids"`
+		Error        string   `json:"error,omitempty"`
+	}
+
+	CraftItemReq struct {
+		RecipeId string `json:"recipe_id" validate:"required,max=64"`
+	}
+
+	CraftItemRes struct {
+		CraftId      string   `json:"craft_id"`
+		RecipeId     string   `json:"recipe_id"`
+		ItemId       string   `json:"item_id"`
+		InventoryIds []string `json:"inventory_ids"`
+	}
+
+	UpgradeItemReq struct {
+		InventoryId string `json:"inventory_id" validate:"required,max=64"`
+	}
+
+	UpgradeItemRes struct {
+		InventoryId  string  `json:"inventory_id"`
+		ItemId       string  `json:"item_id"`
+		Success      bool    `json:"success"`
+		UpgradeLevel int     `json:"upgrade_level"`
+		BonusDamage  int     `json:"bonus_damage"`
+		Cost         float64 `json:"cost"`
+	}
+
+	InventoryHistoryReq struct {
+		ItemId string `query:"item_id" validate:"max=64"`
+		models.PaginateReq
+	}
+
+	InventorySnapshotReq struct {
+		At string `query:"at" validate:"max=64"`
+	}
+
+	InventorySnapshotItem struct {
+		ItemId   string `json:"item_id"`
+		Quantity int64  `json:"quantity"`
+	}
+
+	InventorySnapshotRes struct {
+		PlayerId string                   `json:"player_id"`
+		At       time.Time                `json:"at"`
+		Items    []*InventorySnapshotItem `json:"items"`
+	}
+
+	CreateTradeReq struct {
+		CounterpartyId string `json:"counterparty_id" validate:"required,max=64"`
+	}
+
+	UpdateTradeOfferReq struct {
+		ItemIds []string `json:"item_ids" validate:"max=20,dive,required,max=64"`
+		Coins   float64  `json:"coins" validate:"min=0"`
+	}
+
+	TradeShowCase struct {
+		TradeId      string      `json:"trade_id"`
+		Initiator    *TradeOffer `json:"initiator"`
+		Counterparty *TradeOffer `json:"counterparty"`
+		Status       string      `json:"status"`
+		ExpiresAt    time.Time   `json:"expires_at"`
+		CreatedAt    time.Time   `json:"created_at"`
+		UpdatedAt    time.Time   `json:"updated_at"`
 	}
 )
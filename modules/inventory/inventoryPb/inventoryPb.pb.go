@@ -0,0 +1,1145 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v4.25.3
+// source: modules/inventory/inventoryPb/inventoryPb.proto
+
+package hello_sekai_shop_tutorial
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type InventoryEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id           string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PlayerId     string `protobuf:"bytes,2,opt,name=playerId,proto3" json:"playerId,omitempty"`
+	ItemId       string `protobuf:"bytes,3,opt,name=itemId,proto3" json:"itemId,omitempty"`
+	LockedBy     string `protobuf:"bytes,4,opt,name=lockedBy,proto3" json:"lockedBy,omitempty"`
+	Quantity     int64  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	EquippedSlot string `protobuf:"bytes,6,opt,name=equippedSlot,proto3" json:"equippedSlot,omitempty"`
+	ExpiresAt    string `protobuf:"bytes,7,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+}
+
+func (x *InventoryEntry) Reset() {
+	*x = InventoryEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InventoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InventoryEntry) ProtoMessage() {}
+
+func (x *InventoryEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InventoryEntry.ProtoReflect.Descriptor instead.
+func (*InventoryEntry) Descriptor() ([]byte, []int) {
+	return file_modules_inventory_inventoryPb_inventoryPb_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *InventoryEntry) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *InventoryEntry) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *InventoryEntry) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *InventoryEntry) GetLockedBy() string {
+	if x != nil {
+		return x.LockedBy
+	}
+	return ""
+}
+
+func (x *InventoryEntry) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *InventoryEntry) GetEquippedSlot() string {
+	if x != nil {
+		return x.EquippedSlot
+	}
+	return ""
+}
+
+func (x *InventoryEntry) GetExpiresAt() string {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return ""
+}
+
+type HasItemsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string   `protobuf:"bytes,1,opt,name=playerId,proto3" json:"playerId,omitempty"`
+	ItemIds  []string `protobuf:"bytes,2,rep,name=itemIds,proto3" json:"itemIds,omitempty"`
+}
+
+func (x *HasItemsReq) Reset() {
+	*x = HasItemsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HasItemsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HasItemsReq) ProtoMessage() {}
+
+func (x *HasItemsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HasItemsReq.ProtoReflect.Descriptor instead.
+func (*HasItemsReq) Descriptor() ([]byte, []int) {
+	return file_modules_inventory_inventoryPb_inventoryPb_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *HasItemsReq) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *HasItemsReq) GetItemIds() []string {
+	if x != nil {
+		return x.ItemIds
+	}
+	return nil
+}
+
+type HasItemsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HasAll         bool     `protobuf:"varint,1,opt,name=hasAll,proto3" json:"hasAll,omitempty"`
+	MissingItemIds []string `protobuf:"bytes,2,rep,name=missingItemIds,proto3" json:"missingItemIds,omitempty"`
+}
+
+func (x *HasItemsRes) Reset() {
+	*x = HasItemsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HasItemsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HasItemsRes) ProtoMessage() {}
+
+func (x *HasItemsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HasItemsRes.ProtoReflect.Descriptor instead.
+func (*HasItemsRes) Descriptor() ([]byte, []int) {
+	return file_modules_inventory_inventoryPb_inventoryPb_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *HasItemsRes) GetHasAll() bool {
+	if x != nil {
+		return x.HasAll
+	}
+	return false
+}
+
+func (x *HasItemsRes) GetMissingItemIds() []string {
+	if x != nil {
+		return x.MissingItemIds
+	}
+	return nil
+}
+
+type CountPlayerItemsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string `protobuf:"bytes,1,opt,name=playerId,proto3" json:"playerId,omitempty"`
+	ItemId   string `protobuf:"bytes,2,opt,name=itemId,proto3" json:"itemId,omitempty"`
+}
+
+func (x *CountPlayerItemsReq) Reset() {
+	*x = CountPlayerItemsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountPlayerItemsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountPlayerItemsReq) ProtoMessage() {}
+
+func (x *CountPlayerItemsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountPlayerItemsReq.ProtoReflect.Descriptor instead.
+func (*CountPlayerItemsReq) Descriptor() ([]byte, []int) {
+	return file_modules_inventory_inventoryPb_inventoryPb_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CountPlayerItemsReq) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *CountPlayerItemsReq) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+type CountPlayerItemsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Count int64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *CountPlayerItemsRes) Reset() {
+	*x = CountPlayerItemsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CountPlayerItemsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CountPlayerItemsRes) ProtoMessage() {}
+
+func (x *CountPlayerItemsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CountPlayerItemsRes.ProtoReflect.Descriptor instead.
+func (*CountPlayerItemsRes) Descriptor() ([]byte, []int) {
+	return file_modules_inventory_inventoryPb_inventoryPb_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CountPlayerItemsRes) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type ListPlayerItemsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string `protobuf:"bytes,1,opt,name=playerId,proto3" json:"playerId,omitempty"`
+	Start    string `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	Limit    int32  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListPlayerItemsReq) Reset() {
+	*x = ListPlayerItemsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPlayerItemsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlayerItemsReq) ProtoMessage() {}
+
+func (x *ListPlayerItemsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlayerItemsReq.ProtoReflect.Descriptor instead.
+func (*ListPlayerItemsReq) Descriptor() ([]byte, []int) {
+	return file_modules_inventory_inventoryPb_inventoryPb_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListPlayerItemsReq) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *ListPlayerItemsReq) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *ListPlayerItemsReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListPlayerItemsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries   []*InventoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Total     int64             `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	NextStart string            `protobuf:"bytes,3,opt,name=nextStart,proto3" json:"nextStart,omitempty"`
+}
+
+func (x *ListPlayerItemsRes) Reset() {
+	*x = ListPlayerItemsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListPlayerItemsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPlayerItemsRes) ProtoMessage() {}
+
+func (x *ListPlayerItemsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPlayerItemsRes.ProtoReflect.Descriptor instead.
+func (*ListPlayerItemsRes) Descriptor() ([]byte, []int) {
+	return file_modules_inventory_inventoryPb_inventoryPb_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListPlayerItemsRes) GetEntries() []*InventoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListPlayerItemsRes) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListPlayerItemsRes) GetNextStart() string {
+	if x != nil {
+		return x.NextStart
+	}
+	return ""
+}
+
+type GetInventoryEntryReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InventoryId string `protobuf:"bytes,1,opt,name=inventoryId,proto3" json:"inventoryId,omitempty"`
+}
+
+func (x *GetInventoryEntryReq) Reset() {
+	*x = GetInventoryEntryReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInventoryEntryReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInventoryEntryReq) ProtoMessage() {}
+
+func (x *GetInventoryEntryReq) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInventoryEntryReq.ProtoReflect.Descriptor instead.
+func (*GetInventoryEntryReq) Descriptor() ([]byte, []int) {
+	return file_modules_inventory_inventoryPb_inventoryPb_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetInventoryEntryReq) GetInventoryId() string {
+	if x != nil {
+		return x.InventoryId
+	}
+	return ""
+}
+
+type GetInventoryEntriesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId     string   `protobuf:"bytes,1,opt,name=playerId,proto3" json:"playerId,omitempty"`
+	InventoryIds []string `protobuf:"bytes,2,rep,name=inventoryIds,proto3" json:"inventoryIds,omitempty"`
+}
+
+func (x *GetInventoryEntriesReq) Reset() {
+	*x = GetInventoryEntriesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInventoryEntriesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInventoryEntriesReq) ProtoMessage() {}
+
+func (x *GetInventoryEntriesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInventoryEntriesReq.ProtoReflect.Descriptor instead.
+func (*GetInventoryEntriesReq) Descriptor() ([]byte, []int) {
+	return file_modules_inventory_inventoryPb_inventoryPb_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetInventoryEntriesReq) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *GetInventoryEntriesReq) GetInventoryIds() []string {
+	if x != nil {
+		return x.InventoryIds
+	}
+	return nil
+}
+
+type GetInventoryEntriesRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*InventoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *GetInventoryEntriesRes) Reset() {
+	*x = GetInventoryEntriesRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInventoryEntriesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInventoryEntriesRes) ProtoMessage() {}
+
+func (x *GetInventoryEntriesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInventoryEntriesRes.ProtoReflect.Descriptor instead.
+func (*GetInventoryEntriesRes) Descriptor() ([]byte, []int) {
+	return file_modules_inventory_inventoryPb_inventoryPb_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetInventoryEntriesRes) GetEntries() []*InventoryEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+type GetLoadoutReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string `protobuf:"bytes,1,opt,name=playerId,proto3" json:"playerId,omitempty"`
+}
+
+func (x *GetLoadoutReq) Reset() {
+	*x = GetLoadoutReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLoadoutReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLoadoutReq) ProtoMessage() {}
+
+func (x *GetLoadoutReq) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLoadoutReq.ProtoReflect.Descriptor instead.
+func (*GetLoadoutReq) Descriptor() ([]byte, []int) {
+	return file_modules_inventory_inventoryPb_inventoryPb_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetLoadoutReq) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+type LoadoutSlot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot        string `protobuf:"bytes,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	InventoryId string `protobuf:"bytes,2,opt,name=inventoryId,proto3" json:"inventoryId,omitempty"`
+	ItemId      string `protobuf:"bytes,3,opt,name=itemId,proto3" json:"itemId,omitempty"`
+	Title       string `protobuf:"bytes,4,opt,name=title,proto3" json:"title,omitempty"`
+	Damage      int32  `protobuf:"varint,5,opt,name=damage,proto3" json:"damage,omitempty"`
+	Rarity      string `protobuf:"bytes,6,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	ImageUrl    string `protobuf:"bytes,7,opt,name=imageUrl,proto3" json:"imageUrl,omitempty"`
+}
+
+func (x *LoadoutSlot) Reset() {
+	*x = LoadoutSlot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadoutSlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadoutSlot) ProtoMessage() {}
+
+func (x *LoadoutSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadoutSlot.ProtoReflect.Descriptor instead.
+func (*LoadoutSlot) Descriptor() ([]byte, []int) {
+	return file_modules_inventory_inventoryPb_inventoryPb_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *LoadoutSlot) GetSlot() string {
+	if x != nil {
+		return x.Slot
+	}
+	return ""
+}
+
+func (x *LoadoutSlot) GetInventoryId() string {
+	if x != nil {
+		return x.InventoryId
+	}
+	return ""
+}
+
+func (x *LoadoutSlot) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *LoadoutSlot) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *LoadoutSlot) GetDamage() int32 {
+	if x != nil {
+		return x.Damage
+	}
+	return 0
+}
+
+func (x *LoadoutSlot) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *LoadoutSlot) GetImageUrl() string {
+	if x != nil {
+		return x.ImageUrl
+	}
+	return ""
+}
+
+type Loadout struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string         `protobuf:"bytes,1,opt,name=playerId,proto3" json:"playerId,omitempty"`
+	Slots    []*LoadoutSlot `protobuf:"bytes,2,rep,name=slots,proto3" json:"slots,omitempty"`
+}
+
+func (x *Loadout) Reset() {
+	*x = Loadout{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Loadout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Loadout) ProtoMessage() {}
+
+func (x *Loadout) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Loadout.ProtoReflect.Descriptor instead.
+func (*Loadout) Descriptor() ([]byte, []int) {
+	return file_modules_inventory_inventoryPb_inventoryPb_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Loadout) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *Loadout) GetSlots() []*LoadoutSlot {
+	if x != nil {
+		return x.Slots
+	}
+	return nil
+}
+
+var File_modules_inventory_inventoryPb_inventoryPb_proto protoreflect.FileDescriptor
+
+var file_modules_inventory_inventoryPb_inventoryPb_proto_rawDesc = []byte{
+	0x0a, 0x2f, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74,
+	0x6f, 0x72, 0x79, 0x2f, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x62, 0x2f,
+	0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0xce, 0x01, 0x0a, 0x0e, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x64, 0x42, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x6b,
+	0x65, 0x64, 0x42, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x12, 0x22, 0x0a, 0x0c, 0x65, 0x71, 0x75, 0x69, 0x70, 0x70, 0x65, 0x64, 0x53, 0x6c, 0x6f, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x71, 0x75, 0x69, 0x70, 0x70, 0x65, 0x64,
+	0x53, 0x6c, 0x6f, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x22, 0x43, 0x0a, 0x0b, 0x48, 0x61, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65,
+	0x71, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x73, 0x22, 0x4d, 0x0a, 0x0b, 0x48, 0x61, 0x73, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x61, 0x73, 0x41, 0x6c, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x68, 0x61, 0x73, 0x41, 0x6c, 0x6c, 0x12, 0x26,
+	0x0a, 0x0e, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x49,
+	0x74, 0x65, 0x6d, 0x49, 0x64, 0x73, 0x22, 0x49, 0x0a, 0x13, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x74, 0x65,
+	0x6d, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49,
+	0x64, 0x22, 0x2b, 0x0a, 0x13, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72,
+	0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x5c,
+	0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d,
+	0x73, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x73, 0x0a, 0x12,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52,
+	0x65, 0x73, 0x12, 0x29, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x22, 0x38, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72,
+	0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e, 0x76,
+	0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x22, 0x58, 0x0a, 0x16, 0x47,
+	0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f,
+	0x72, 0x79, 0x49, 0x64, 0x73, 0x22, 0x43, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65,
+	0x6e, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x12,
+	0x29, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x2b, 0x0a, 0x0d, 0x47, 0x65,
+	0x74, 0x4c, 0x6f, 0x61, 0x64, 0x6f, 0x75, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x22, 0xbd, 0x01, 0x0a, 0x0b, 0x4c, 0x6f, 0x61, 0x64,
+	0x6f, 0x75, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x69,
+	0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69,
+	0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x64,
+	0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x64, 0x61, 0x6d,
+	0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69,
+	0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x22, 0x49, 0x0a, 0x07, 0x4c, 0x6f, 0x61, 0x64, 0x6f,
+	0x75, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x22,
+	0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x4c, 0x6f, 0x61, 0x64, 0x6f, 0x75, 0x74, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05, 0x73, 0x6c, 0x6f,
+	0x74, 0x73, 0x32, 0xe9, 0x02, 0x0a, 0x14, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79,
+	0x47, 0x72, 0x70, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x26, 0x0a, 0x08, 0x48,
+	0x61, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x0c, 0x2e, 0x48, 0x61, 0x73, 0x49, 0x74, 0x65,
+	0x6d, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0c, 0x2e, 0x48, 0x61, 0x73, 0x49, 0x74, 0x65, 0x6d, 0x73,
+	0x52, 0x65, 0x73, 0x12, 0x3e, 0x0a, 0x10, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x14, 0x2e,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73,
+	0x52, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x13, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73,
+	0x12, 0x3b, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x15, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x6e,
+	0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x1a, 0x0f, 0x2e, 0x49,
+	0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x47, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x17, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74,
+	0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x17, 0x2e,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61,
+	0x64, 0x6f, 0x75, 0x74, 0x12, 0x0e, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x6f, 0x75,
+	0x74, 0x52, 0x65, 0x71, 0x1a, 0x08, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x6f, 0x75, 0x74, 0x42, 0x2f,
+	0x5a, 0x2d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x70, 0x70,
+	0x6c, 0x65, 0x73, 0x73, 0x72, 0x2f, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x2d, 0x73, 0x65, 0x6b, 0x61,
+	0x69, 0x2d, 0x73, 0x68, 0x6f, 0x70, 0x2d, 0x74, 0x75, 0x74, 0x6f, 0x72, 0x69, 0x61, 0x6c, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_modules_inventory_inventoryPb_inventoryPb_proto_rawDescOnce sync.Once
+	file_modules_inventory_inventoryPb_inventoryPb_proto_rawDescData = file_modules_inventory_inventoryPb_inventoryPb_proto_rawDesc
+)
+
+func file_modules_inventory_inventoryPb_inventoryPb_proto_rawDescGZIP() []byte {
+	file_modules_inventory_inventoryPb_inventoryPb_proto_rawDescOnce.Do(func() {
+		file_modules_inventory_inventoryPb_inventoryPb_proto_rawDescData = protoimpl.X.CompressGZIP(file_modules_inventory_inventoryPb_inventoryPb_proto_rawDescData)
+	})
+	return file_modules_inventory_inventoryPb_inventoryPb_proto_rawDescData
+}
+
+var file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_modules_inventory_inventoryPb_inventoryPb_proto_goTypes = []interface{}{
+	(*InventoryEntry)(nil),         // 0: InventoryEntry
+	(*HasItemsReq)(nil),            // 1: HasItemsReq
+	(*HasItemsRes)(nil),            // 2: HasItemsRes
+	(*CountPlayerItemsReq)(nil),    // 3: CountPlayerItemsReq
+	(*CountPlayerItemsRes)(nil),    // 4: CountPlayerItemsRes
+	(*ListPlayerItemsReq)(nil),     // 5: ListPlayerItemsReq
+	(*ListPlayerItemsRes)(nil),     // 6: ListPlayerItemsRes
+	(*GetInventoryEntryReq)(nil),   // 7: GetInventoryEntryReq
+	(*GetInventoryEntriesReq)(nil), // 8: GetInventoryEntriesReq
+	(*GetInventoryEntriesRes)(nil), // 9: GetInventoryEntriesRes
+	(*GetLoadoutReq)(nil),          // 10: GetLoadoutReq
+	(*LoadoutSlot)(nil),            // 11: LoadoutSlot
+	(*Loadout)(nil),                // 12: Loadout
+}
+var file_modules_inventory_inventoryPb_inventoryPb_proto_depIdxs = []int32{
+	0,  // 0: ListPlayerItemsRes.entries:type_name -> InventoryEntry
+	0,  // 1: GetInventoryEntriesRes.entries:type_name -> InventoryEntry
+	11, // 2: Loadout.slots:type_name -> LoadoutSlot
+	1,  // 3: InventoryGrpcService.HasItems:input_type -> HasItemsReq
+	3,  // 4: InventoryGrpcService.CountPlayerItems:input_type -> CountPlayerItemsReq
+	5,  // 5: InventoryGrpcService.ListPlayerItems:input_type -> ListPlayerItemsReq
+	7,  // 6: InventoryGrpcService.GetInventoryEntry:input_type -> GetInventoryEntryReq
+	8,  // 7: InventoryGrpcService.GetInventoryEntries:input_type -> GetInventoryEntriesReq
+	10, // 8: InventoryGrpcService.GetLoadout:input_type -> GetLoadoutReq
+	2,  // 9: InventoryGrpcService.HasItems:output_type -> HasItemsRes
+	4,  // 10: InventoryGrpcService.CountPlayerItems:output_type -> CountPlayerItemsRes
+	6,  // 11: InventoryGrpcService.ListPlayerItems:output_type -> ListPlayerItemsRes
+	0,  // 12: InventoryGrpcService.GetInventoryEntry:output_type -> InventoryEntry
+	9,  // 13: InventoryGrpcService.GetInventoryEntries:output_type -> GetInventoryEntriesRes
+	12, // 14: InventoryGrpcService.GetLoadout:output_type -> Loadout
+	9,  // [9:15] is the sub-list for method output_type
+	3,  // [3:9] is the sub-list for method input_type
+	3,  // [3:3] is the sub-list for extension type_name
+	3,  // [3:3] is the sub-list for extension extendee
+	0,  // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_modules_inventory_inventoryPb_inventoryPb_proto_init() }
+func file_modules_inventory_inventoryPb_inventoryPb_proto_init() {
+	if File_modules_inventory_inventoryPb_inventoryPb_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InventoryEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HasItemsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HasItemsRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountPlayerItemsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CountPlayerItemsRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPlayerItemsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPlayerItemsRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInventoryEntryReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInventoryEntriesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInventoryEntriesRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLoadoutReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadoutSlot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Loadout); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_modules_inventory_inventoryPb_inventoryPb_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   13,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_modules_inventory_inventoryPb_inventoryPb_proto_goTypes,
+		DependencyIndexes: file_modules_inventory_inventoryPb_inventoryPb_proto_depIdxs,
+		MessageInfos:      file_modules_inventory_inventoryPb_inventoryPb_proto_msgTypes,
+	}.Build()
+	File_modules_inventory_inventoryPb_inventoryPb_proto = out.File
+	file_modules_inventory_inventoryPb_inventoryPb_proto_rawDesc = nil
+	file_modules_inventory_inventoryPb_inventoryPb_proto_goTypes = nil
+	file_modules_inventory_inventoryPb_inventoryPb_proto_depIdxs = nil
+}
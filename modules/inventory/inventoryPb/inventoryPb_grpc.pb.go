@@ -0,0 +1,285 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v4.25.3
+// source: modules/inventory/inventoryPb/inventoryPb.proto
+
+package hello_sekai_shop_tutorial
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// InventoryGrpcServiceClient is the client API for InventoryGrpcService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type InventoryGrpcServiceClient interface {
+	HasItems(ctx context.Context, in *HasItemsReq, opts ...grpc.CallOption) (*HasItemsRes, error)
+	CountPlayerItems(ctx context.Context, in *CountPlayerItemsReq, opts ...grpc.CallOption) (*CountPlayerItemsRes, error)
+	ListPlayerItems(ctx context.Context, in *ListPlayerItemsReq, opts ...grpc.CallOption) (*ListPlayerItemsRes, error)
+	GetInventoryEntry(ctx context.Context, in *GetInventoryEntryReq, opts ...grpc.CallOption) (*InventoryEntry, error)
+	GetInventoryEntries(ctx context.Context, in *GetInventoryEntriesReq, opts ...grpc.CallOption) (*GetInventoryEntriesRes, error)
+	GetLoadout(ctx context.Context, in *GetLoadoutReq, opts ...grpc.CallOption) (*Loadout, error)
+}
+
+type inventoryGrpcServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInventoryGrpcServiceClient(cc grpc.ClientConnInterface) InventoryGrpcServiceClient {
+	return &inventoryGrpcServiceClient{cc}
+}
+
+func (c *inventoryGrpcServiceClient) HasItems(ctx context.Context, in *HasItemsReq, opts ...grpc.CallOption) (*HasItemsRes, error) {
+	out := new(HasItemsRes)
+	err := c.cc.Invoke(ctx, "/InventoryGrpcService/HasItems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryGrpcServiceClient) CountPlayerItems(ctx context.Context, in *CountPlayerItemsReq, opts ...grpc.CallOption) (*CountPlayerItemsRes, error) {
+	out := new(CountPlayerItemsRes)
+	err := c.cc.Invoke(ctx, "/InventoryGrpcService/CountPlayerItems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryGrpcServiceClient) ListPlayerItems(ctx context.Context, in *ListPlayerItemsReq, opts ...grpc.CallOption) (*ListPlayerItemsRes, error) {
+	out := new(ListPlayerItemsRes)
+	err := c.cc.Invoke(ctx, "/InventoryGrpcService/ListPlayerItems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryGrpcServiceClient) GetInventoryEntry(ctx context.Context, in *GetInventoryEntryReq, opts ...grpc.CallOption) (*InventoryEntry, error) {
+	out := new(InventoryEntry)
+	err := c.cc.Invoke(ctx, "/InventoryGrpcService/GetInventoryEntry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryGrpcServiceClient) GetInventoryEntries(ctx context.Context, in *GetInventoryEntriesReq, opts ...grpc.CallOption) (*GetInventoryEntriesRes, error) {
+	out := new(GetInventoryEntriesRes)
+	err := c.cc.Invoke(ctx, "/InventoryGrpcService/GetInventoryEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *inventoryGrpcServiceClient) GetLoadout(ctx context.Context, in *GetLoadoutReq, opts ...grpc.CallOption) (*Loadout, error) {
+	out := new(Loadout)
+	err := c.cc.Invoke(ctx, "/InventoryGrpcService/GetLoadout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InventoryGrpcServiceServer is the server API for InventoryGrpcService service.
+// All implementations must embed UnimplementedInventoryGrpcServiceServer
+// for forward compatibility
+type InventoryGrpcServiceServer interface {
+	HasItems(context.Context, *HasItemsReq) (*HasItemsRes, error)
+	CountPlayerItems(context.Context, *CountPlayerItemsReq) (*CountPlayerItemsRes, error)
+	ListPlayerItems(context.Context, *ListPlayerItemsReq) (*ListPlayerItemsRes, error)
+	GetInventoryEntry(context.Context, *GetInventoryEntryReq) (*InventoryEntry, error)
+	GetInventoryEntries(context.Context, *GetInventoryEntriesReq) (*GetInventoryEntriesRes, error)
+	GetLoadout(context.Context, *GetLoadoutReq) (*Loadout, error)
+	mustEmbedUnimplementedInventoryGrpcServiceServer()
+}
+
+// UnimplementedInventoryGrpcServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedInventoryGrpcServiceServer struct {
+}
+
+func (UnimplementedInventoryGrpcServiceServer) HasItems(context.Context, *HasItemsReq) (*HasItemsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HasItems not implemented")
+}
+func (UnimplementedInventoryGrpcServiceServer) CountPlayerItems(context.Context, *CountPlayerItemsReq) (*CountPlayerItemsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CountPlayerItems not implemented")
+}
+func (UnimplementedInventoryGrpcServiceServer) ListPlayerItems(context.Context, *ListPlayerItemsReq) (*ListPlayerItemsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPlayerItems not implemented")
+}
+func (UnimplementedInventoryGrpcServiceServer) GetInventoryEntry(context.Context, *GetInventoryEntryReq) (*InventoryEntry, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInventoryEntry not implemented")
+}
+func (UnimplementedInventoryGrpcServiceServer) GetInventoryEntries(context.Context, *GetInventoryEntriesReq) (*GetInventoryEntriesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInventoryEntries not implemented")
+}
+func (UnimplementedInventoryGrpcServiceServer) GetLoadout(context.Context, *GetLoadoutReq) (*Loadout, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoadout not implemented")
+}
+func (UnimplementedInventoryGrpcServiceServer) mustEmbedUnimplementedInventoryGrpcServiceServer() {}
+
+// UnsafeInventoryGrpcServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InventoryGrpcServiceServer will
+// result in compilation errors.
+type UnsafeInventoryGrpcServiceServer interface {
+	mustEmbedUnimplementedInventoryGrpcServiceServer()
+}
+
+func RegisterInventoryGrpcServiceServer(s grpc.ServiceRegistrar, srv InventoryGrpcServiceServer) {
+	s.RegisterService(&InventoryGrpcService_ServiceDesc, srv)
+}
+
+func _InventoryGrpcService_HasItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HasItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryGrpcServiceServer).HasItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/InventoryGrpcService/HasItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryGrpcServiceServer).HasItems(ctx, req.(*HasItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryGrpcService_CountPlayerItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CountPlayerItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryGrpcServiceServer).CountPlayerItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/InventoryGrpcService/CountPlayerItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryGrpcServiceServer).CountPlayerItems(ctx, req.(*CountPlayerItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryGrpcService_ListPlayerItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPlayerItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryGrpcServiceServer).ListPlayerItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/InventoryGrpcService/ListPlayerItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryGrpcServiceServer).ListPlayerItems(ctx, req.(*ListPlayerItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryGrpcService_GetInventoryEntry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInventoryEntryReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryGrpcServiceServer).GetInventoryEntry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/InventoryGrpcService/GetInventoryEntry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryGrpcServiceServer).GetInventoryEntry(ctx, req.(*GetInventoryEntryReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryGrpcService_GetInventoryEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInventoryEntriesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryGrpcServiceServer).GetInventoryEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/InventoryGrpcService/GetInventoryEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryGrpcServiceServer).GetInventoryEntries(ctx, req.(*GetInventoryEntriesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _InventoryGrpcService_GetLoadout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoadoutReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InventoryGrpcServiceServer).GetLoadout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/InventoryGrpcService/GetLoadout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InventoryGrpcServiceServer).GetLoadout(ctx, req.(*GetLoadoutReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// InventoryGrpcService_ServiceDesc is the grpc.ServiceDesc for InventoryGrpcService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var InventoryGrpcService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "InventoryGrpcService",
+	HandlerType: (*InventoryGrpcServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "HasItems",
+			Handler:    _InventoryGrpcService_HasItems_Handler,
+		},
+		{
+			MethodName: "CountPlayerItems",
+			Handler:    _InventoryGrpcService_CountPlayerItems_Handler,
+		},
+		{
+			MethodName: "ListPlayerItems",
+			Handler:    _InventoryGrpcService_ListPlayerItems_Handler,
+		},
+		{
+			MethodName: "GetInventoryEntry",
+			Handler:    _InventoryGrpcService_GetInventoryEntry_Handler,
+		},
+		{
+			MethodName: "GetInventoryEntries",
+			Handler:    _InventoryGrpcService_GetInventoryEntries_Handler,
+		},
+		{
+			MethodName: "GetLoadout",
+			Handler:    _InventoryGrpcService_GetLoadout_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "modules/inventory/inventoryPb/inventoryPb.proto",
+}
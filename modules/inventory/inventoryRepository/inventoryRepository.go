@@ -12,7 +12,9 @@ import (
 	itemPb "github.com/Applessr/hello-sekai-shop-tutorial/modules/item/itemPb"
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/models"
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/payment"
+	playerPb "github.com/Applessr/hello-sekai-shop-tutorial/modules/player/playerPb"
 	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/grpccon"
+	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/itemcache"
 	jwtAuth "github.com/Applessr/hello-sekai-shop-tutorial/pkg/jwtauth"
 	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/queue"
 	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/utils"
@@ -29,21 +31,74 @@ type (
 		FindItemInIds(pctx context.Context, grpcUrl string, req *itemPb.FindItemInIdsReq) (*itemPb.FindItemInIdsRes, error)
 		FindPlayerItems(pctx context.Context, filter primitive.D, opts []*options.FindOptions) ([]*inventory.Inventory, error)
 		CountPlayerItems(pctx context.Context, playerId string) (int64, error)
+		CountPlayerItemsInIds(pctx context.Context, playerId string, itemIds []string, unlockedOnly, unequippedOnly bool) (map[string]int64, error)
+		FindOneInventory(pctx context.Context, inventoryId string) (*inventory.Inventory, error)
 		AddPlayerItemRes(pctx context.Context, cfg *config.Config, req *payment.PaymentTransferRes) error
 		RemovePlayerItemRes(pctx context.Context, cfg *config.Config, req *payment.PaymentTransferRes) error
 		InsertOnePlayerItem(pctx context.Context, req *inventory.Inventory) (primitive.ObjectID, error)
-		DeleteOneInventory(pctx context.Context, inventoryId string) error
+		AddOnePlayerItem(pctx context.Context, req *inventory.Inventory, maxStack int, capacity int64) (string, error)
+		FindExpiredInventories(pctx context.Context) ([]*inventory.Inventory, error)
+		DeleteExpiredInventory(pctx context.Context, inventoryId string) bool
+		PublishInventoryEvent(pctx context.Context, cfg *config.Config, req *inventory.InventoryEvent) error
+		InsertOneInventoryEvent(pctx context.Context, req *inventory.InventoryEvent) error
+		CountPlayerItemsByFilter(pctx context.Context, filter primitive.D) (int64, error)
+		FindSnapshotVersions(pctx context.Context) (map[string]int64, error)
+		UpdateItemSnapshots(pctx context.Context, itemId string, req *inventory.ItemSnapshot) error
+		InsertOneAdminJob(pctx context.Context, req *inventory.AdminJob) (primitive.ObjectID, error)
+		FindOneAdminJob(pctx context.Context, jobId string) (*inventory.AdminJob, error)
+		ClaimAdminJob(pctx context.Context) (*inventory.AdminJob, error)
+		UpdateOneAdminJob(pctx context.Context, jobId, lease string, req primitive.M) error
+		CountItemOwners(pctx context.Context, itemId string) (int64, error)
+		FindItemOwners(pctx context.Context, itemId, after string, limit int64) ([]string, error)
+		FindInventoryEvents(pctx context.Context, filter primitive.D, opts []*options.FindOptions) ([]*inventory.InventoryEvent, error)
+		CountInventoryEvents(pctx context.Context, filter primitive.D) (int64, error)
+		SumInventoryEvents(pctx context.Context, playerId string, at time.Time) (map[string]int64, error)
+		FindOrInsertCapacity(pctx context.Context, playerId string, baseCapacity int64) (int64, error)
+		IncreaseCapacity(pctx context.Context, playerId string, slots, baseCapacity int64) error
+		InsertOneExpansion(pctx context.Context, req *inventory.InventoryExpansion) (primitive.ObjectID, error)
+		DeleteOneExpansion(pctx context.Context, expansionId string) (*inventory.InventoryExpansion, error)
+		EquipOnePlayerItem(pctx context.Context, playerId, inventoryId, slot string) error
+		UnequipPlayerSlot(pctx context.Context, playerId, slot string) error
+		DeleteOneInventory(pctx context.Context, inventoryId string) (*inventory.Inventory, error)
+		InsertOneCraft(pctx context.Context, req *inventory.Craft) (primitive.ObjectID, error)
+		UpdateOneCraft(pctx context.Context, craftId string, req primitive.M) error
+		ClaimStuckCraft(pctx context.Context) (*inventory.Craft, error)
 		FindOnePlayerItem(pctx context.Context, playerId, itemId string) bool
-		DeleteOnePlayerItem(pctx context.Context, playerId, itemId string) error
+		DeleteOnePlayerItem(pctx context.Context, playerId, itemId string) (*inventory.Inventory, error)
+		RestoreOneInventory(pctx context.Context, req *inventory.Inventory) (bool, error)
+		LockOnePlayerItem(pctx context.Context, playerId, itemId, tradeId string, capacity int64) (string, error)
+		UnlockPlayerItems(pctx context.Context, playerId, tradeId string) error
+		TransferOnePlayerItem(pctx context.Context, inventoryId, fromPlayerId, toPlayerId, tradeId string) error
+		InsertOneTrade(pctx context.Context, req *inventory.Trade) (primitive.ObjectID, error)
+		FindOneTrade(pctx context.Context, tradeId string) (*inventory.Trade, error)
+		FindExpiredTrades(pctx context.Context) ([]*inventory.Trade, error)
+		FindStuckTrades(pctx context.Context) ([]*inventory.Trade, error)
+		UpdateOneTrade(pctx context.Context, tradeId string, req primitive.M) error
+		UpdateTradeStatus(pctx context.Context, tradeId, fromStatus, toStatus string) bool
+		StartTrade(pctx context.Context, tradeId string) (*inventory.Trade, error)
+		AddTradeTransaction(pctx context.Context, tradeId, transactionId string) error
+		FindOnePlayerProfile(pctx context.Context, grpcUrl string, req *playerPb.FindOnePlayerProfileToRefreshReq) (*playerPb.PlayerProfile, error)
+		CreatePlayerTransaction(pctx context.Context, grpcUrl string, req *playerPb.CreatePlayerTransactionReq) (*playerPb.CreatePlayerTransactionRes, error)
+		RollbackPlayerTransaction(pctx context.Context, grpcUrl string, req *playerPb.RollbackPlayerTransactionReq) error
+		FindRecipe(pctx context.Context, grpcUrl string, req *itemPb.GetRecipeReq) (*itemPb.Recipe, error)
+		FindUpgradePath(pctx context.Context, grpcUrl string, req *itemPb.GetUpgradePathReq) (*itemPb.UpgradePath, error)
+		UpgradeOneInventory(pctx context.Context, playerId, inventoryId string, fromLevel, bonusDamage int) error
 	}
 
 	inventoryRepository struct {
-		db *mongo.Client
+		db        *mongo.Client
+		itemCache itemcache.ItemCacheService
 	}
 )
 
-func NewInventoryRepository(db *mongo.Client) InventoryRepositoryService {
-	return &inventoryRepository{db}
+func NewInventoryRepository(db *mongo.Client, itemCache itemcache.ItemCacheService) InventoryRepositoryService {
+	return &inventoryRepository{db, itemCache}
+}
+
+// notExpired is matched against expires_at, it lets through entries without an expiry
+// and those whose expiry is still ahead
+func notExpired() bson.M {
+	return bson.M{"$not": bson.M{"$lte": utils.LocalTime()}}
 }
 
 func (r *inventoryRepository) inventoryDbConnect(pctx context.Context) *mongo.Database {
@@ -84,6 +139,16 @@ func (r *inventoryRepository) UpserOffset(pctx context.Context, offset int64) er
 }
 
 func (r *inventoryRepository) FindItemInIds(pctx context.Context, grpcUrl string, req *itemPb.FindItemInIdsReq) (*itemPb.FindItemInIdsRes, error) {
+	if r.itemCache == nil {
+		return r.findItemInIds(pctx, grpcUrl, req)
+	}
+
+	return r.itemCache.FindItemInIds(pctx, req, func(pctx context.Context, req *itemPb.FindItemInIdsReq) (*itemPb.FindItemInIdsRes, error) {
+		return r.findItemInIds(pctx, grpcUrl, req)
+	})
+}
+
+func (r *inventoryRepository) findItemInIds(pctx context.Context, grpcUrl string, req *itemPb.FindItemInIdsReq) (*itemPb.FindItemInIdsRes, error) {
 	ctx, cancel := context.WithTimeout(pctx, 30*time.Second)
 	defer cancel()
 
@@ -100,19 +165,9 @@ func (r *inventoryRepository) FindItemInIds(pctx context.Context, grpcUrl string
 		return nil, errors.New("error: items not found")
 	}
 
-	if result == nil {
-		log.Printf("Error: FindItemInIds failed: %s", err.Error())
-		return nil, errors.New("error: items not found")
-	}
-
-	if result.Items == nil {
-		log.Printf("Error: FindItemInIds failed: %s", err.Error())
-		return nil, errors.New("error: items not found")
-	}
-
-	if len(result.Items) == 0 {
-		log.Printf("Error: FindItemInIds failed: %s", err.Error())
-		return nil, errors.New("error: items not found")
+	// The item service answered, ids it left out are disabled or deleted rather than unreachable
+	if result == nil || result.Items == nil {
+		return &itemPb.FindItemInIdsRes{Items: make([]*itemPb.Item, 0)}, nil
 	}
 
 	return result, nil
@@ -125,6 +180,8 @@ func (r *inventoryRepository) FindPlayerItems(pctx context.Context, filter primi
 	db := r.inventoryDbConnect(ctx)
 	col := db.Collection("players_inventory")
 
+	filter = append(filter, bson.E{"expires_at", notExpired()})
+
 	cursors, err := col.Find(ctx, filter, opts...)
 	if err != nil {
 		log.Printf("Error: FindPlayerItems failed: %s", err.Error())
@@ -152,7 +209,7 @@ func (r *inventoryRepository) CountPlayerItems(pctx context.Context, playerId st
 	db := r.inventoryDbConnect(ctx)
 	col := db.Collection("players_inventory")
 
-	count, err := col.CountDocuments(ctx, bson.M{"player_id": playerId})
+	count, err := col.CountDocuments(ctx, bson.M{"player_id": playerId, "expires_at": notExpired()})
 	if err != nil {
 		log.Printf("Error: CountPlayerItems failed: %s", err.Error())
 		return -1, errors.New("error: count player items failed")
@@ -161,6 +218,68 @@ func (r *inventoryRepository) CountPlayerItems(pctx context.Context, playerId st
 	return count, nil
 }
 
+// CountPlayerItemsInIds counts units per item, unlockedOnly and unequippedOnly together count what
+// DeleteOnePlayerItem is able to take
+func (r *inventoryRepository) CountPlayerItemsInIds(pctx context.Context, playerId string, itemIds []string, unlockedOnly, unequippedOnly bool) (map[string]int64, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("players_inventory")
+
+	match := bson.D{{"player_id", playerId}, {"expires_at", notExpired()}}
+	if itemIds != nil {
+		match = append(match, bson.E{"item_id", bson.M{"$in": itemIds}})
+	}
+	if unlockedOnly {
+		match = append(match, bson.E{"locked_by", bson.M{"$exists": false}})
+	}
+	if unequippedOnly {
+		match = append(match, bson.E{"equipped_slot", bson.M{"$exists": false}})
+	}
+
+	cursors, err := col.Aggregate(ctx, mongo.Pipeline{
+		{{"$match", match}},
+		{{"$group", bson.D{{"_id", "$item_id"}, {"count", bson.M{"$sum": bson.M{"$ifNull": bson.A{"$quantity", 1}}}}}}},
+	})
+	if err != nil {
+		log.Printf("Error: CountPlayerItemsInIds failed: %s", err.Error())
+		return nil, errors.New("error: count player items failed")
+	}
+
+	results := make(map[string]int64)
+	for cursors.Next(ctx) {
+		result := new(struct {
+			ItemId string `bson:"_id"`
+			Count  int64  `bson:"count"`
+		})
+		if err := cursors.Decode(result); err != nil {
+			log.Printf("Error: CountPlayerItemsInIds failed: %s", err.Error())
+			return nil, errors.New("error: count player items failed")
+		}
+		results[result.ItemId] = result.Count
+	}
+
+	return results, nil
+}
+
+func (r *inventoryRepository) FindOneInventory(pctx context.Context, inventoryId string) (*inventory.Inventory, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("players_inventory")
+
+	result := new(inventory.Inventory)
+
+	if err := col.FindOne(ctx, bson.M{"_id": utils.ConvertToObjectId(inventoryId)}).Decode(result); err != nil {
+		log.Printf("Error: FindOneInventory failed: %s", err.Error())
+		return nil, errors.New("error: inventory not found")
+	}
+
+	return result, nil
+}
+
 func (r *inventoryRepository) InsertOnePlayerItem(pctx context.Context, req *inventory.Inventory) (primitive.ObjectID, error) {
 	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
 	defer cancel()
@@ -177,23 +296,157 @@ func (r *inventoryRepository) InsertOnePlayerItem(pctx context.Context, req *inv
 	return result.InsertedID.(primitive.ObjectID), nil
 }
 
-func (r *inventoryRepository) DeleteOneInventory(pctx context.Context, inventoryId string) error {
+// AddOnePlayerItem puts one unit on a stack that still has room, otherwise it starts a new entry
+// as long as the player has a free slot, a capacity of 0 skips the slot check. Entries that
+// expire or were upgraded never stack since each of them is one of a kind
+func (r *inventoryRepository) AddOnePlayerItem(pctx context.Context, req *inventory.Inventory, maxStack int, capacity int64) (string, error) {
 	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
 	defer cancel()
 
 	db := r.inventoryDbConnect(ctx)
 	col := db.Collection("players_inventory")
 
-	result, err := col.DeleteOne(ctx, bson.M{"_id": utils.ConvertToObjectId(inventoryId)})
+	playerId := req.PlayerId
+
+	if maxStack > 1 && req.ExpiresAt == nil {
+		result := new(inventory.Inventory)
+		err := col.FindOneAndUpdate(
+			ctx,
+			bson.M{"player_id": playerId, "item_id": req.ItemId, "locked_by": bson.M{"$exists": false}, "equipped_slot": bson.M{"$exists": false}, "expires_at": bson.M{"$exists": false}, "upgrade_level": bson.M{"$exists": false}, "quantity": bson.M{"$lt": maxStack}},
+			bson.M{"$inc": bson.M{"quantity": 1}},
+		).Decode(result)
+		if err == nil {
+			return result.Id.Hex(), nil
+		}
+		if err != mongo.ErrNoDocuments {
+			log.Printf("Error: AddOnePlayerItem failed: %s", err.Error())
+			return "", errors.New("error: add player item failed")
+		}
+	}
+
+	req.Quantity = 1
+	result, err := col.InsertOne(ctx, req)
 	if err != nil {
+		log.Printf("Error: AddOnePlayerItem failed: %s", err.Error())
+		return "", errors.New("error: add player item failed")
+	}
+	inventoryId := result.InsertedID.(primitive.ObjectID)
+
+	// The slot is taken first and counted afterwards, so two adds racing for the last slot
+	// can't both see it free, the one that finds the inventory over capacity gives it back
+	if capacity > 0 {
+		used, err := col.CountDocuments(ctx, bson.M{"player_id": playerId, "expires_at": notExpired()})
+		if err != nil || used > capacity {
+			if _, err := col.DeleteOne(ctx, bson.M{"_id": inventoryId}); err != nil {
+				log.Printf("Error: AddOnePlayerItem failed: release %s: %s", inventoryId.Hex(), err.Error())
+			}
+		}
+		if err != nil {
+			log.Printf("Error: AddOnePlayerItem failed: %s", err.Error())
+			return "", errors.New("error: add player item failed")
+		}
+		if used > capacity {
+			log.Printf("Error: AddOnePlayerItem failed: player %s uses %d of %d slots", playerId, used-1, capacity)
+			return "", inventory.ErrInventoryFull
+		}
+	}
+
+	return inventoryId.Hex(), nil
+}
+
+// DeleteOneInventory takes one unit off the entry and removes it once the stack is empty,
+// it returns the entry as it was before or nil when the entry is already gone
+func (r *inventoryRepository) DeleteOneInventory(pctx context.Context, inventoryId string) (*inventory.Inventory, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("players_inventory")
+
+	result := new(inventory.Inventory)
+	err := col.FindOneAndUpdate(ctx, bson.M{"_id": utils.ConvertToObjectId(inventoryId), "quantity": bson.M{"$gt": 1}}, bson.M{"$inc": bson.M{"quantity": -1}}).Decode(result)
+	if err == nil {
+		return result, nil
+	}
+	if err != mongo.ErrNoDocuments {
+		log.Printf("Error: DeleteOneInventory failed: %s", err.Error())
+		return nil, errors.New("error: delete one inventory failed")
+	}
+
+	if err := col.FindOneAndDelete(ctx, bson.M{"_id": utils.ConvertToObjectId(inventoryId)}).Decode(result); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
 		log.Printf("Error: DeleteOneInventory failed: %s", err.Error())
-		return errors.New("error: delete one inventory failed")
+		return nil, errors.New("error: delete one inventory failed")
+	}
+
+	return result, nil
+}
+
+func (r *inventoryRepository) InsertOneCraft(pctx context.Context, req *inventory.Craft) (primitive.ObjectID, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("players_crafts")
+
+	result, err := col.InsertOne(ctx, req)
+	if err != nil {
+		log.Printf("Error: InsertOneCraft failed: %s", err.Error())
+		return primitive.NilObjectID, errors.New("error: insert one craft failed")
+	}
+
+	return result.InsertedID.(primitive.ObjectID), nil
+}
+
+func (r *inventoryRepository) UpdateOneCraft(pctx context.Context, craftId string, req primitive.M) error {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("players_crafts")
+
+	if _, err := col.UpdateOne(ctx, bson.M{"_id": utils.ConvertToObjectId(craftId)}, req); err != nil {
+		log.Printf("Error: UpdateOneCraft failed: %s", err.Error())
+		return errors.New("error: update one craft failed")
 	}
-	log.Printf("DeleteOneInventory result: %v", result)
 
 	return nil
 }
 
+// ClaimStuckCraft takes over a craft that has not moved for five minutes, its worker is assumed
+// dead. It returns nil without error when there is none
+func (r *inventoryRepository) ClaimStuckCraft(pctx context.Context) (*inventory.Craft, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("players_crafts")
+
+	now := utils.LocalTime()
+
+	result := new(inventory.Craft)
+	err := col.FindOneAndUpdate(
+		ctx,
+		bson.M{
+			"status":     bson.M{"$in": bson.A{"consuming", "charging", "granting", "compensating"}},
+			"updated_at": bson.M{"$lt": now.Add(-5 * time.Minute)},
+		},
+		bson.M{"$set": bson.M{"updated_at": now}},
+		options.FindOneAndUpdate().SetSort(bson.D{{"updated_at", 1}}).SetReturnDocument(options.After),
+	).Decode(result)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		log.Printf("Error: ClaimStuckCraft failed: %s", err.Error())
+		return nil, errors.New("error: claim stuck craft failed")
+	}
+
+	return result, nil
+}
+
 func (r *inventoryRepository) AddPlayerItemRes(pctx context.Context, cfg *config.Config, req *payment.PaymentTransferRes) error {
 	reqInBytes, err := json.Marshal(req)
 	if err != nil {
@@ -225,28 +478,68 @@ func (r *inventoryRepository) FindOnePlayerItem(pctx context.Context, playerId,
 
 	result := new(inventory.Inventory)
 
-	if err := col.FindOne(ctx, bson.M{"player_id": playerId, "item_id": itemId}).Decode(result); err != nil {
+	if err := col.FindOne(ctx, bson.M{"player_id": playerId, "item_id": itemId, "locked_by": bson.M{"$exists": false}, "equipped_slot": bson.M{"$exists": false}, "expires_at": notExpired()}).Decode(result); err != nil {
 		log.Printf("Error: FindOnePlayerItem failed: %s", err.Error())
 		return false
 	}
 	return true
 }
 
-func (r *inventoryRepository) DeleteOnePlayerItem(pctx context.Context, playerId, itemId string) error {
+// DeleteOnePlayerItem takes one unit, stacks are drawn down before single entries are deleted
+// and upgraded entries go last. It returns the entry the unit came from as it was before
+func (r *inventoryRepository) DeleteOnePlayerItem(pctx context.Context, playerId, itemId string) (*inventory.Inventory, error) {
 	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
 	defer cancel()
 
 	db := r.inventoryDbConnect(ctx)
 	col := db.Collection("players_inventory")
 
-	result, err := col.DeleteOne(ctx, bson.M{"player_id": playerId, "item_id": itemId})
-	if err != nil {
+	result := new(inventory.Inventory)
+	err := col.FindOneAndUpdate(
+		ctx,
+		bson.M{"player_id": playerId, "item_id": itemId, "locked_by": bson.M{"$exists": false}, "equipped_slot": bson.M{"$exists": false}, "quantity": bson.M{"$gt": 1}},
+		bson.M{"$inc": bson.M{"quantity": -1}},
+	).Decode(result)
+	if err == nil {
+		return result, nil
+	}
+	if err != mongo.ErrNoDocuments {
 		log.Printf("Error: DeleteOnePlayerItem failed: %s", err.Error())
-		return errors.New("error: delete one player item failed")
+		return nil, errors.New("error: delete one player item failed")
 	}
-	log.Printf("DeleteOnePlayerItem result: %v", result)
 
-	return nil
+	if err := col.FindOneAndDelete(
+		ctx,
+		bson.M{"player_id": playerId, "item_id": itemId, "locked_by": bson.M{"$exists": false}, "equipped_slot": bson.M{"$exists": false}, "expires_at": notExpired()},
+		options.FindOneAndDelete().SetSort(bson.D{{"upgrade_level", 1}}),
+	).Decode(result); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, errors.New("error: item not found")
+		}
+		log.Printf("Error: DeleteOnePlayerItem failed: %s", err.Error())
+		return nil, errors.New("error: delete one player item failed")
+	}
+
+	return result, nil
+}
+
+// RestoreOneInventory puts a removed entry back under its own id, false means it is already back
+func (r *inventoryRepository) RestoreOneInventory(pctx context.Context, req *inventory.Inventory) (bool, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("players_inventory")
+
+	if _, err := col.InsertOne(ctx, req); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return false, nil
+		}
+		log.Printf("Error: RestoreOneInventory failed: %s", err.Error())
+		return false, errors.New("error: restore one inventory failed")
+	}
+
+	return true, nil
 }
 
 func (r *inventoryRepository) RemovePlayerItemRes(pctx context.Context, cfg *config.Config, req *payment.PaymentTransferRes) error {
@@ -270,3 +563,1049 @@ func (r *inventoryRepository) RemovePlayerItemRes(pctx context.Context, cfg *con
 
 	return nil
 }
+
+// LockOnePlayerItem locks one unit for the trade. A stack gives up a single unit, which moves into
+// its own locked entry and takes a slot like AddOnePlayerItem, a capacity of 0 skips the slot check.
+// The unit remembers its stack so UnlockPlayerItems can put it back
+func (r *inventoryRepository) LockOnePlayerItem(pctx context.Context, playerId, itemId, tradeId string, capacity int64) (string, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("players_inventory")
+
+	stack := new(inventory.Inventory)
+	err := col.FindOneAndUpdate(
+		ctx,
+		bson.M{"player_id": playerId, "item_id": itemId, "locked_by": bson.M{"$exists": false}, "equipped_slot": bson.M{"$exists": false}, "quantity": bson.M{"$gt": 1}},
+		bson.M{"$inc": bson.M{"quantity": -1}},
+	).Decode(stack)
+	if err == nil {
+		giveBack := func() {
+			if _, err := col.UpdateOne(ctx, bson.M{"_id": stack.Id}, bson.M{"$inc": bson.M{"quantity": 1}}); err != nil {
+				log.Printf("Error: LockOnePlayerItem failed: unit not given back to %s: %s", stack.Id.Hex(), err.Error())
+			}
+		}
+
+		unit, err := col.InsertOne(ctx, &inventory.Inventory{
+			PlayerId:  playerId,
+			ItemId:    itemId,
+			Quantity:  1,
+			LockedBy:  tradeId,
+			Item:      stack.Item,
+			SplitFrom: stack.Id.Hex(),
+		})
+		if err != nil {
+			giveBack()
+			log.Printf("Error: LockOnePlayerItem failed: %s", err.Error())
+			return "", errors.New("error: lock player item failed")
+		}
+		unitId := unit.InsertedID.(primitive.ObjectID)
+
+		if capacity > 0 {
+			used, err := col.CountDocuments(ctx, bson.M{"player_id": playerId, "expires_at": notExpired()})
+			if err != nil || used > capacity {
+				if _, err := col.DeleteOne(ctx, bson.M{"_id": unitId}); err != nil {
+					log.Printf("Error: LockOnePlayerItem failed: release %s: %s", unitId.Hex(), err.Error())
+				}
+				giveBack()
+			}
+			if err != nil {
+				log.Printf("Error: LockOnePlayerItem failed: %s", err.Error())
+				return "", errors.New("error: lock player item failed")
+			}
+			if used > capacity {
+				log.Printf("Error: LockOnePlayerItem failed: player %s uses %d of %d slots", playerId, used-1, capacity)
+				return "", inventory.ErrInventoryFull
+			}
+		}
+
+		return unitId.Hex(), nil
+	}
+	if err != mongo.ErrNoDocuments {
+		log.Printf("Error: LockOnePlayerItem failed: %s", err.Error())
+		return "", errors.New("error: lock player item failed")
+	}
+
+	result := new(inventory.Inventory)
+	if err := col.FindOneAndUpdate(
+		ctx,
+		bson.M{"player_id": playerId, "item_id": itemId, "locked_by": bson.M{"$exists": false}, "equipped_slot": bson.M{"$exists": false}, "expires_at": notExpired()},
+		bson.M{"$set": bson.M{"locked_by": tradeId}},
+	).Decode(result); err != nil {
+		log.Printf("Error: LockOnePlayerItem failed: %s", err.Error())
+		return "", errors.New("error: lock player item failed")
+	}
+
+	return result.Id.Hex(), nil
+}
+
+// UnlockPlayerItems releases what the player has locked for the trade, a unit split off a stack
+// goes back onto that stack while the player still holds it unlocked
+func (r *inventoryRepository) UnlockPlayerItems(pctx context.Context, playerId, tradeId string) error {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("players_inventory")
+
+	cursors, err := col.Find(ctx, bson.M{"player_id": playerId, "locked_by": tradeId, "split_from": bson.M{"$exists": true}})
+	if err != nil {
+		log.Printf("Error: UnlockPlayerItems failed: %s", err.Error())
+		return errors.New("error: unlock player items failed")
+	}
+	units := make([]*inventory.Inventory, 0)
+	if err := cursors.All(ctx, &units); err != nil {
+		log.Printf("Error: UnlockPlayerItems failed: %s", err.Error())
+		return errors.New("error: unlock player items failed")
+	}
+
+	merged := 0
+	for _, unit := range units {
+		// The unit is taken first so it can't be merged twice, it is put back on its own when
+		// the stack is gone or no longer free
+		if err := col.FindOneAndDelete(ctx, bson.M{"_id": unit.Id, "locked_by": tradeId}).Err(); err != nil {
+			if err == mongo.ErrNoDocuments {
+				continue
+			}
+			log.Printf("Error: UnlockPlayerItems failed: %s", err.Error())
+			return errors.New("error: unlock player items failed")
+		}
+
+		result, err := col.UpdateOne(
+			ctx,
+			bson.M{"_id": utils.ConvertToObjectId(unit.SplitFrom), "player_id": playerId, "locked_by": bson.M{"$exists": false}, "equipped_slot": bson.M{"$exists": false}},
+			bson.M{"$inc": bson.M{"quantity": 1}},
+		)
+		if err == nil && result.MatchedCount == 1 {
+			merged++
+			continue
+		}
+
+		unit.LockedBy = ""
+		unit.SplitFrom = ""
+		if _, err := col.InsertOne(ctx, unit); err != nil {
+			log.Printf("Error: UnlockPlayerItems failed: unit %s of %s lost: %s", unit.Id.Hex(), playerId, err.Error())
+			return errors.New("error: unlock player items failed")
+		}
+	}
+
+	result, err := col.UpdateMany(ctx, bson.M{"player_id": playerId, "locked_by": tradeId}, bson.M{"$unset": bson.M{"locked_by": "", "split_from": ""}})
+	if err != nil {
+		log.Printf("Error: UnlockPlayerItems failed: %s", err.Error())
+		return errors.New("error: unlock player items failed")
+	}
+	log.Printf("Info: UnlockPlayerItems: %d merged back, %d unlocked", merged, result.ModifiedCount)
+
+	return nil
+}
+
+// TransferOnePlayerItem hands the entry over only while it is still held the way the caller expects,
+// locked by the trade or, with an empty trade id, not locked at all. The lock moves along with the
+// entry and is released once the trade settles
+func (r *inventoryRepository) TransferOnePlayerItem(pctx context.Context, inventoryId, fromPlayerId, toPlayerId, tradeId string) error {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("players_inventory")
+
+	filter := bson.M{"_id": utils.ConvertToObjectId(inventoryId), "player_id": fromPlayerId}
+	if tradeId == "" {
+		filter["locked_by"] = bson.M{"$exists": false}
+	} else {
+		filter["locked_by"] = tradeId
+	}
+
+	result, err := col.UpdateOne(ctx, filter, bson.M{"$set": bson.M{"player_id": toPlayerId}, "$unset": bson.M{"split_from": ""}})
+	if err != nil {
+		log.Printf("Error: TransferOnePlayerItem failed: %s", err.Error())
+		return errors.New("error: transfer player item failed")
+	}
+
+	if result.ModifiedCount == 0 {
+		log.Printf("Error: TransferOnePlayerItem failed: inventory %s not held by %s for trade %q", inventoryId, fromPlayerId, tradeId)
+		return errors.New("error: transfer player item failed")
+	}
+
+	return nil
+}
+
+func (r *inventoryRepository) InsertOneTrade(pctx context.Context, req *inventory.Trade) (primitive.ObjectID, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("players_trades")
+
+	result, err := col.InsertOne(ctx, req)
+	if err != nil {
+		log.Printf("Error: InsertOneTrade failed: %s", err.Error())
+		return primitive.NilObjectID, errors.New("error: insert trade failed")
+	}
+
+	return result.InsertedID.(primitive.ObjectID), nil
+}
+
+func (r *inventoryRepository) FindOneTrade(pctx context.Context, tradeId string) (*inventory.Trade, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("players_trades")
+
+	result := new(inventory.Trade)
+	if err := col.FindOne(ctx, bson.M{"_id": utils.ConvertToObjectId(tradeId)}).Decode(result); err != nil {
+		log.Printf("Error: FindOneTrade failed: %s", err.Error())
+		return nil, errors.New("error: trade not found")
+	}
+
+	return result, nil
+}
+
+func (r *inventoryRepository) FindExpiredTrades(pctx context.Context) ([]*inventory.Trade, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("players_trades")
+
+	cursors, err := col.Find(ctx, bson.M{"status": "pending", "expires_at": bson.M{"$lt": utils.LocalTime()}})
+	if err != nil {
+		log.Printf("Error: FindExpiredTrades failed: %s", err.Error())
+		return nil, errors.New("error: find expired trades failed")
+	}
+
+	results := make([]*inventory.Trade, 0)
+	for cursors.Next(ctx) {
+		result := new(inventory.Trade)
+		if err := cursors.Decode(result); err != nil {
+			log.Printf("Error: FindExpiredTrades failed: %s", err.Error())
+			return nil, errors.New("error: find expired trades failed")
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// FindStuckTrades lists the trades whose worker stopped halfway, nothing has touched them for five minutes
+func (r *inventoryRepository) FindStuckTrades(pctx context.Context) ([]*inventory.Trade, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("players_trades")
+
+	cursors, err := col.Find(ctx, bson.M{
+		"status":     bson.M{"$in": bson.A{"processing", "settling"}},
+		"updated_at": bson.M{"$lt": utils.LocalTime().Add(-5 * time.Minute)},
+	})
+	if err != nil {
+		log.Printf("Error: FindStuckTrades failed: %s", err.Error())
+		return nil, errors.New("error: find stuck trades failed")
+	}
+
+	results := make([]*inventory.Trade, 0)
+	for cursors.Next(ctx) {
+		result := new(inventory.Trade)
+		if err := cursors.Decode(result); err != nil {
+			log.Printf("Error: FindStuckTrades failed: %s", err.Error())
+			return nil, errors.New("error: find stuck trades failed")
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func (r *inventoryRepository) UpdateOneTrade(pctx context.Context, tradeId string, req primitive.M) error {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("players_trades")
+
+	result, err := col.UpdateOne(ctx, bson.M{"_id": utils.ConvertToObjectId(tradeId), "status": "pending"}, bson.M{"$set": req})
+	if err != nil {
+		log.Printf("Error: UpdateOneTrade failed: %s", err.Error())
+		return errors.New("error: update trade failed")
+	}
+
+	if result.MatchedCount == 0 {
+		log.Printf("Error: UpdateOneTrade failed: trade %s is not pending", tradeId)
+		return errors.New("error: trade is no longer pending")
+	}
+
+	return nil
+}
+
+func (r *inventoryRepository) UpdateTradeStatus(pctx context.Context, tradeId, fromStatus, toStatus string) bool {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("players_trades")
+
+	result, err := col.UpdateOne(
+		ctx,
+		bson.M{"_id": utils.ConvertToObjectId(tradeId), "status": fromStatus},
+		bson.M{"$set": bson.M{"status": toStatus, "updated_at": utils.LocalTime()}},
+	)
+	if err != nil {
+		log.Printf("Error: UpdateTradeStatus failed: %s", err.Error())
+		return false
+	}
+
+	return result.ModifiedCount == 1
+}
+
+// StartTrade moves a pending trade that both sides confirmed to processing and returns it as it was
+// at that moment, it returns nil without error when the trade is not ready or another call got there first
+func (r *inventoryRepository) StartTrade(pctx context.Context, tradeId string) (*inventory.Trade, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("players_trades")
+
+	now := utils.LocalTime()
+
+	result := new(inventory.Trade)
+	err := col.FindOneAndUpdate(
+		ctx,
+		bson.M{
+			"_id":                    utils.ConvertToObjectId(tradeId),
+			"status":                 "pending",
+			"initiator.confirmed":    true,
+			"counterparty.confirmed": true,
+			"expires_at":             bson.M{"$gt": now},
+		},
+		bson.M{"$set": bson.M{"status": "processing", "updated_at": now}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(result)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		log.Printf("Error: StartTrade failed: %s", err.Error())
+		return nil, errors.New("error: start trade failed")
+	}
+
+	return result, nil
+}
+
+// AddTradeTransaction saves a coin transaction the trade made, so it can still be rolled back
+// if the worker stops before the trade settles
+func (r *inventoryRepository) AddTradeTransaction(pctx context.Context, tradeId, transactionId string) error {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("players_trades")
+
+	result, err := col.UpdateOne(
+		ctx,
+		bson.M{"_id": utils.ConvertToObjectId(tradeId), "status": "processing"},
+		bson.M{"$push": bson.M{"transaction_ids": transactionId}, "$set": bson.M{"updated_at": utils.LocalTime()}},
+	)
+	if err != nil {
+		log.Printf("Error: AddTradeTransaction failed: %s", err.Error())
+		return errors.New("error: save trade transaction failed")
+	}
+	if result.MatchedCount == 0 {
+		log.Printf("Error: AddTradeTransaction failed: trade %s is not processing", tradeId)
+		return errors.New("error: trade is no longer processing")
+	}
+
+	return nil
+}
+
+// FindOnePlayerProfile asks the player service for the profile, a player that does not exist
+// comes back with a zero id
+func (r *inventoryRepository) FindOnePlayerProfile(pctx context.Context, grpcUrl string, req *playerPb.FindOnePlayerProfileToRefreshReq) (*playerPb.PlayerProfile, error) {
+	ctx, cancel := context.WithTimeout(pctx, 30*time.Second)
+	defer cancel()
+
+	jwtAuth.SetApiKeyInContext(&ctx)
+	conn, err := grpccon.NewGrpcClient(grpcUrl)
+	if err != nil {
+		log.Printf("Error: gRPC connection failed: %s", err.Error())
+		return nil, errors.New("error: gRPC connection failed")
+	}
+
+	result, err := conn.Player().FindOnePlayerProfileToRefresh(ctx, req)
+	if err != nil {
+		log.Printf("Error: FindOnePlayerProfile failed: %s", err.Error())
+		return nil, errors.New("error: player profile not found")
+	}
+
+	return result, nil
+}
+
+func (r *inventoryRepository) CreatePlayerTransaction(pctx context.Context, grpcUrl string, req *playerPb.CreatePlayerTransactionReq) (*playerPb.CreatePlayerTransactionRes, error) {
+	ctx, cancel := context.WithTimeout(pctx, 30*time.Second)
+	defer cancel()
+
+	jwtAuth.SetApiKeyInContext(&ctx)
+	conn, err := grpccon.NewGrpcClient(grpcUrl)
+	if err != nil {
+		log.Printf("Error: gRPC connection failed: %s", err.Error())
+		return nil, errors.New("error: gRPC connection failed")
+	}
+
+	result, err := conn.Player().CreatePlayerTransaction(ctx, req)
+	if err != nil {
+		log.Printf("Error: CreatePlayerTransaction failed: %s", err.Error())
+		return nil, errors.New("error: create player transaction failed")
+	}
+
+	return result, nil
+}
+
+func (r *inventoryRepository) RollbackPlayerTransaction(pctx context.Context, grpcUrl string, req *playerPb.RollbackPlayerTransactionReq) error {
+	ctx, cancel := context.WithTimeout(pctx, 30*time.Second)
+	defer cancel()
+
+	jwtAuth.SetApiKeyInContext(&ctx)
+	conn, err := grpccon.NewGrpcClient(grpcUrl)
+	if err != nil {
+		log.Printf("Error: gRPC connection failed: %s", err.Error())
+		return errors.New("error: gRPC connection failed")
+	}
+
+	if _, err := conn.Player().RollbackPlayerTransaction(ctx, req); err != nil {
+		log.Printf("Error: RollbackPlayerTransaction failed: %s", err.Error())
+		return errors.New("error: rollback player transaction failed")
+	}
+
+	return nil
+}
+
+func (r *inventoryRepository) FindOrInsertCapacity(pctx context.Context, playerId string, baseCapacity int64) (int64, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("players_inventory_capacity")
+
+	result := new(inventory.InventoryCapacity)
+	if err := col.FindOneAndUpdate(
+		ctx,
+		bson.M{"player_id": playerId},
+		bson.M{"$setOnInsert": bson.M{"player_id": playerId, "capacity": baseCapacity, "updated_at": utils.LocalTime()}},
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+	).Decode(result); err != nil {
+		log.Printf("Error: FindOrInsertCapacity failed: %s", err.Error())
+		return -1, errors.New("error: find inventory capacity failed")
+	}
+
+	return result.Capacity, nil
+}
+
+func (r *inventoryRepository) IncreaseCapacity(pctx context.Context, playerId string, slots, baseCapacity int64) error {
+	if _, err := r.FindOrInsertCapacity(pctx, playerId, baseCapacity); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("players_inventory_capacity")
+
+	if _, err := col.UpdateOne(
+		ctx,
+		bson.M{"player_id": playerId},
+		bson.M{"$inc": bson.M{"capacity": slots}, "$set": bson.M{"updated_at": utils.LocalTime()}},
+	); err != nil {
+		log.Printf("Error: IncreaseCapacity failed: %s", err.Error())
+		return errors.New("error: increase inventory capacity failed")
+	}
+
+	return nil
+}
+
+func (r *inventoryRepository) InsertOneExpansion(pctx context.Context, req *inventory.InventoryExpansion) (primitive.ObjectID, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("players_inventory_expansions")
+
+	result, err := col.InsertOne(ctx, req)
+	if err != nil {
+		log.Printf("Error: InsertOneExpansion failed: %s", err.Error())
+		return primitive.NilObjectID, errors.New("error: insert inventory expansion failed")
+	}
+
+	return result.InsertedID.(primitive.ObjectID), nil
+}
+
+// DeleteOneExpansion returns nil without error when the id is not an expansion
+func (r *inventoryRepository) DeleteOneExpansion(pctx context.Context, expansionId string) (*inventory.InventoryExpansion, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("players_inventory_expansions")
+
+	result := new(inventory.InventoryExpansion)
+	if err := col.FindOneAndDelete(ctx, bson.M{"_id": utils.ConvertToObjectId(expansionId)}).Decode(result); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		log.Printf("Error: DeleteOneExpansion failed: %s", err.Error())
+		return nil, errors.New("error: delete inventory expansion failed")
+	}
+
+	return result, nil
+}
+
+// EquipOnePlayerItem puts the entry in the slot, whatever was there before goes back to the bag.
+// Only one entry may hold a slot, so the previous one is cleared first and given the slot back
+// if the new entry can't take it
+func (r *inventoryRepository) EquipOnePlayerItem(pctx context.Context, playerId, inventoryId, slot string) error {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("players_inventory")
+
+	objectId := utils.ConvertToObjectId(inventoryId)
+	filter := bson.M{"_id": objectId, "player_id": playerId, "locked_by": bson.M{"$exists": false}}
+
+	if err := col.FindOne(ctx, filter).Err(); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return errors.New("error: inventory entry not found")
+		}
+		log.Printf("Error: EquipOnePlayerItem failed: %s", err.Error())
+		return errors.New("error: equip player item failed")
+	}
+
+	previous := new(inventory.Inventory)
+	if err := col.FindOneAndUpdate(
+		ctx,
+		bson.M{"player_id": playerId, "equipped_slot": slot, "_id": bson.M{"$ne": objectId}},
+		bson.M{"$unset": bson.M{"equipped_slot": ""}},
+	).Decode(previous); err != nil {
+		if !errors.Is(err, mongo.ErrNoDocuments) {
+			log.Printf("Error: EquipOnePlayerItem failed: %s", err.Error())
+			return errors.New("error: equip player item failed")
+		}
+		previous = nil
+	}
+
+	restore := func() {
+		if previous == nil {
+			return
+		}
+		if _, err := col.UpdateOne(
+			ctx,
+			bson.M{"_id": previous.Id, "equipped_slot": bson.M{"$exists": false}},
+			bson.M{"$set": bson.M{"equipped_slot": slot}},
+		); err != nil {
+			log.Printf("Error: EquipOnePlayerItem failed: restore %s to %s: %s", previous.Id.Hex(), slot, err.Error())
+		}
+	}
+
+	result, err := col.UpdateOne(ctx, filter, bson.M{"$set": bson.M{"equipped_slot": slot}})
+	if err != nil {
+		restore()
+		log.Printf("Error: EquipOnePlayerItem failed: %s", err.Error())
+		return errors.New("error: equip player item failed")
+	}
+	if result.MatchedCount == 0 {
+		restore()
+		return errors.New("error: inventory entry not found")
+	}
+
+	return nil
+}
+
+func (r *inventoryRepository) UnequipPlayerSlot(pctx context.Context, playerId, slot string) error {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("players_inventory")
+
+	result, err := col.UpdateOne(ctx, bson.M{"player_id": playerId, "equipped_slot": slot}, bson.M{"$unset": bson.M{"equipped_slot": ""}})
+	if err != nil {
+		log.Printf("Error: UnequipPlayerSlot failed: %s", err.Error())
+		return errors.New("error: unequip player item failed")
+	}
+	if result.MatchedCount == 0 {
+		return errors.New("error: slot is empty")
+	}
+
+	return nil
+}
+
+func (r *inventoryRepository) FindExpiredInventories(pctx context.Context) ([]*inventory.Inventory, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("players_inventory")
+
+	// Entries held by a trade are left until the trade settles
+	cursors, err := col.Find(
+		ctx,
+		bson.M{"expires_at": bson.M{"$lte": utils.LocalTime()}, "locked_by": bson.M{"$exists": false}},
+		options.Find().SetLimit(100),
+	)
+	if err != nil {
+		log.Printf("Error: FindExpiredInventories failed: %s", err.Error())
+		return nil, errors.New("error: expired inventories not found")
+	}
+
+	results := make([]*inventory.Inventory, 0)
+	for cursors.Next(ctx) {
+		result := new(inventory.Inventory)
+		if err := cursors.Decode(result); err != nil {
+			log.Printf("Error: FindExpiredInventories failed: %s", err.Error())
+			return nil, errors.New("error: expired inventories not found")
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// DeleteExpiredInventory reports whether this call removed the entry, so only one sweeper publishes it
+func (r *inventoryRepository) DeleteExpiredInventory(pctx context.Context, inventoryId string) bool {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("players_inventory")
+
+	result, err := col.DeleteOne(ctx, bson.M{
+		"_id":        utils.ConvertToObjectId(inventoryId),
+		"expires_at": bson.M{"$lte": utils.LocalTime()},
+		"locked_by":  bson.M{"$exists": false},
+	})
+	if err != nil {
+		log.Printf("Error: DeleteExpiredInventory failed: %s", err.Error())
+		return false
+	}
+
+	return result.DeletedCount == 1
+}
+
+func (r *inventoryRepository) PublishInventoryEvent(pctx context.Context, cfg *config.Config, req *inventory.InventoryEvent) error {
+	reqInBytes, err := json.Marshal(req)
+	if err != nil {
+		log.Printf("Error: PublishInventoryEvent failed: %s", err.Error())
+		return errors.New("error: publish inventory event failed")
+	}
+
+	// Keyed by player id so every event of one player lands on the same partition in order
+	if err := queue.PushMessageWithKeyToQueue(
+		[]string{cfg.Kafka.Url},
+		cfg.Kafka.ApiKey,
+		cfg.Kafka.Secret,
+		"inventory_event",
+		req.PlayerId,
+		reqInBytes,
+	); err != nil {
+		log.Printf("Error: PublishInventoryEvent failed: %s", err.Error())
+		return errors.New("error: publish inventory event failed")
+	}
+
+	return nil
+}
+
+func (r *inventoryRepository) InsertOneInventoryEvent(pctx context.Context, req *inventory.InventoryEvent) error {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("inventory_events")
+
+	// A retried write that already landed is not an error
+	if _, err := col.InsertOne(ctx, req); err != nil && !mongo.IsDuplicateKeyError(err) {
+		log.Printf("Error: InsertOneInventoryEvent failed: %s", err.Error())
+		return errors.New("error: insert one inventory event failed")
+	}
+
+	return nil
+}
+
+func (r *inventoryRepository) FindInventoryEvents(pctx context.Context, filter primitive.D, opts []*options.FindOptions) ([]*inventory.InventoryEvent, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("inventory_events")
+
+	cursors, err := col.Find(ctx, filter, opts...)
+	if err != nil {
+		log.Printf("Error: FindInventoryEvents failed: %s", err.Error())
+		return nil, errors.New("error: inventory events not found")
+	}
+
+	results := make([]*inventory.InventoryEvent, 0)
+	for cursors.Next(ctx) {
+		result := new(inventory.InventoryEvent)
+		if err := cursors.Decode(result); err != nil {
+			log.Printf("Error: FindInventoryEvents failed: %s", err.Error())
+			return nil, errors.New("error: inventory events not found")
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func (r *inventoryRepository) CountInventoryEvents(pctx context.Context, filter primitive.D) (int64, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("inventory_events")
+
+	count, err := col.CountDocuments(ctx, filter)
+	if err != nil {
+		log.Printf("Error: CountInventoryEvents failed: %s", err.Error())
+		return -1, errors.New("error: count inventory events failed")
+	}
+
+	return count, nil
+}
+
+// SumInventoryEvents adds up the player's events per item up to the given moment
+func (r *inventoryRepository) SumInventoryEvents(pctx context.Context, playerId string, at time.Time) (map[string]int64, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("inventory_events")
+
+	cursors, err := col.Aggregate(ctx, mongo.Pipeline{
+		{{"$match", bson.D{{"player_id", playerId}, {"occurred_at", bson.D{{"$lte", at}}}}}},
+		{{"$group", bson.D{{"_id", "$item_id"}, {"quantity", bson.D{{"$sum", "$quantity"}}}}}},
+	})
+	if err != nil {
+		log.Printf("Error: SumInventoryEvents failed: %s", err.Error())
+		return nil, errors.New("error: sum inventory events failed")
+	}
+
+	results := make(map[string]int64)
+	for cursors.Next(ctx) {
+		result := new(struct {
+			ItemId   string `bson:"_id"`
+			Quantity int64  `bson:"quantity"`
+		})
+		if err := cursors.Decode(result); err != nil {
+			log.Printf("Error: SumInventoryEvents failed: %s", err.Error())
+			return nil, errors.New("error: sum inventory events failed")
+		}
+
+		results[result.ItemId] = result.Quantity
+	}
+
+	return results, nil
+}
+
+func (r *inventoryRepository) CountPlayerItemsByFilter(pctx context.Context, filter primitive.D) (int64, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("players_inventory")
+
+	filter = append(filter, bson.E{"expires_at", notExpired()})
+
+	count, err := col.CountDocuments(ctx, filter)
+	if err != nil {
+		log.Printf("Error: CountPlayerItemsByFilter failed: %s", err.Error())
+		return -1, errors.New("error: count player items failed")
+	}
+
+	return count, nil
+}
+
+// FindSnapshotVersions returns the oldest snapshot version per item the player holds,
+// -1 for an item with an entry that has no snapshot yet
+func (r *inventoryRepository) FindSnapshotVersions(pctx context.Context) (map[string]int64, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("players_inventory")
+
+	cursors, err := col.Aggregate(ctx, mongo.Pipeline{
+		{{"$match", bson.M{"expires_at": notExpired()}}},
+		{{"$group", bson.M{"_id": "$item_id", "version": bson.M{"$min": bson.M{"$ifNull": bson.A{"$item.version", -1}}}}}},
+	})
+	if err != nil {
+		log.Printf("Error: FindSnapshotVersions failed: %s", err.Error())
+		return nil, errors.New("error: snapshot versions not found")
+	}
+
+	results := make(map[string]int64)
+	for cursors.Next(ctx) {
+		result := new(struct {
+			ItemId  string `bson:"_id"`
+			Version int64  `bson:"version"`
+		})
+		if err := cursors.Decode(result); err != nil {
+			log.Printf("Error: FindSnapshotVersions failed: %s", err.Error())
+			return nil, errors.New("error: snapshot versions not found")
+		}
+		results[result.ItemId] = result.Version
+	}
+
+	return results, nil
+}
+
+// UpdateItemSnapshots refreshes every entry of the item whose snapshot is missing or older than req
+func (r *inventoryRepository) UpdateItemSnapshots(pctx context.Context, itemId string, req *inventory.ItemSnapshot) error {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("players_inventory")
+
+	result, err := col.UpdateMany(
+		ctx,
+		bson.M{"item_id": itemId, "expires_at": notExpired(), "$or": bson.A{
+			bson.M{"item": bson.M{"$exists": false}},
+			bson.M{"item.version": bson.M{"$lt": req.Version}},
+		}},
+		bson.M{"$set": bson.M{"item": req}},
+	)
+	if err != nil {
+		log.Printf("Error: UpdateItemSnapshots failed: %s", err.Error())
+		return errors.New("error: update item snapshots failed")
+	}
+	log.Printf("Info: UpdateItemSnapshots result: %v", result.ModifiedCount)
+
+	return nil
+}
+
+func (r *inventoryRepository) InsertOneAdminJob(pctx context.Context, req *inventory.AdminJob) (primitive.ObjectID, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("inventory_admin_jobs")
+
+	result, err := col.InsertOne(ctx, req)
+	if err != nil {
+		log.Printf("Error: InsertOneAdminJob failed: %s", err.Error())
+		return primitive.NilObjectID, errors.New("error: insert admin job failed")
+	}
+
+	return result.InsertedID.(primitive.ObjectID), nil
+}
+
+func (r *inventoryRepository) FindOneAdminJob(pctx context.Context, jobId string) (*inventory.AdminJob, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("inventory_admin_jobs")
+
+	result := new(inventory.AdminJob)
+	if err := col.FindOne(ctx, bson.M{"_id": utils.ConvertToObjectId(jobId)}).Decode(result); err != nil {
+		log.Printf("Error: FindOneAdminJob failed: %s", err.Error())
+		return nil, errors.New("error: admin job not found")
+	}
+
+	return result, nil
+}
+
+// ClaimAdminJob marks the oldest pending job as running and returns it, a running job that has
+// not reported progress for five minutes is taken over as its worker is assumed dead.
+// It returns nil without error when there is nothing to run
+func (r *inventoryRepository) ClaimAdminJob(pctx context.Context) (*inventory.AdminJob, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("inventory_admin_jobs")
+
+	now := utils.LocalTime()
+
+	result := new(inventory.AdminJob)
+	err := col.FindOneAndUpdate(
+		ctx,
+		bson.M{"$or": bson.A{
+			bson.M{"status": "pending"},
+			bson.M{"status": "running", "updated_at": bson.M{"$lt": now.Add(-5 * time.Minute)}},
+		}},
+		bson.M{"$set": bson.M{"status": "running", "lease": primitive.NewObjectID().Hex(), "updated_at": now}},
+		options.FindOneAndUpdate().SetSort(bson.D{{"created_at", 1}}).SetReturnDocument(options.After),
+	).Decode(result)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		log.Printf("Error: ClaimAdminJob failed: %s", err.Error())
+		return nil, errors.New("error: claim admin job failed")
+	}
+
+	return result, nil
+}
+
+// UpdateOneAdminJob only writes while the job is still held under the given lease
+func (r *inventoryRepository) UpdateOneAdminJob(pctx context.Context, jobId, lease string, req primitive.M) error {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("inventory_admin_jobs")
+
+	result, err := col.UpdateOne(ctx, bson.M{"_id": utils.ConvertToObjectId(jobId), "lease": lease}, req)
+	if err != nil {
+		log.Printf("Error: UpdateOneAdminJob failed: %s", err.Error())
+		return errors.New("error: update admin job failed")
+	}
+	if result.MatchedCount == 0 {
+		log.Printf("Error: UpdateOneAdminJob failed: job %s was taken over", jobId)
+		return errors.New("error: admin job lease lost")
+	}
+
+	return nil
+}
+
+func (r *inventoryRepository) CountItemOwners(pctx context.Context, itemId string) (int64, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("players_inventory")
+
+	cursors, err := col.Aggregate(ctx, mongo.Pipeline{
+		{{"$match", bson.D{{"item_id", itemId}, {"expires_at", notExpired()}}}},
+		{{"$group", bson.D{{"_id", "$player_id"}}}},
+		{{"$count", "total"}},
+	})
+	if err != nil {
+		log.Printf("Error: CountItemOwners failed: %s", err.Error())
+		return -1, errors.New("error: count item owners failed")
+	}
+
+	result := new(struct {
+		Total int64 `bson:"total"`
+	})
+	if cursors.Next(ctx) {
+		if err := cursors.Decode(result); err != nil {
+			log.Printf("Error: CountItemOwners failed: %s", err.Error())
+			return -1, errors.New("error: count item owners failed")
+		}
+	}
+
+	return result.Total, nil
+}
+
+// FindItemOwners lists the players holding the item in player id order, starting after the given id
+func (r *inventoryRepository) FindItemOwners(pctx context.Context, itemId, after string, limit int64) ([]string, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("players_inventory")
+
+	cursors, err := col.Aggregate(ctx, mongo.Pipeline{
+		{{"$match", bson.D{{"item_id", itemId}, {"player_id", bson.D{{"$gt", after}}}, {"expires_at", notExpired()}}}},
+		{{"$group", bson.D{{"_id", "$player_id"}}}},
+		{{"$sort", bson.D{{"_id", 1}}}},
+		{{"$limit", limit}},
+	})
+	if err != nil {
+		log.Printf("Error: FindItemOwners failed: %s", err.Error())
+		return nil, errors.New("error: item owners not found")
+	}
+
+	results := make([]string, 0)
+	for cursors.Next(ctx) {
+		result := new(struct {
+			PlayerId string `bson:"_id"`
+		})
+		if err := cursors.Decode(result); err != nil {
+			log.Printf("Error: FindItemOwners failed: %s", err.Error())
+			return nil, errors.New("error: item owners not found")
+		}
+		results = append(results, result.PlayerId)
+	}
+
+	return results, nil
+}
+
+func (r *inventoryRepository) FindRecipe(pctx context.Context, grpcUrl string, req *itemPb.GetRecipeReq) (*itemPb.Recipe, error) {
+	ctx, cancel := context.WithTimeout(pctx, 30*time.Second)
+	defer cancel()
+
+	jwtAuth.SetApiKeyInContext(&ctx)
+	conn, err := grpccon.NewGrpcClient(grpcUrl)
+	if err != nil {
+		log.Printf("Error: gRPC connection failed: %s", err.Error())
+		return nil, errors.New("error: gRPC connection failed")
+	}
+
+	result, err := conn.Item().GetRecipe(ctx, req)
+	if err != nil {
+		log.Printf("Error: FindRecipe failed: %s", err.Error())
+		return nil, errors.New("error: recipe not found")
+	}
+
+	return result, nil
+}
+
+func (r *inventoryRepository) FindUpgradePath(pctx context.Context, grpcUrl string, req *itemPb.GetUpgradePathReq) (*itemPb.UpgradePath, error) {
+	ctx, cancel := context.WithTimeout(pctx, 30*time.Second)
+	defer cancel()
+
+	jwtAuth.SetApiKeyInContext(&ctx)
+	conn, err := grpccon.NewGrpcClient(grpcUrl)
+	if err != nil {
+		log.Printf("Error: gRPC connection failed: %s", err.Error())
+		return nil, errors.New("error: gRPC connection failed")
+	}
+
+	result, err := conn.Item().GetUpgradePath(ctx, req)
+	if err != nil {
+		log.Printf("Error: FindUpgradePath failed: %s", err.Error())
+		return nil, errors.New("error: upgrade path not found")
+	}
+
+	return result, nil
+}
+
+// UpgradeOneInventory moves the player's entry one level up, it only matches while the entry is still
+// at fromLevel so two upgrades paid for at once can't both land. Entries saved before quantities
+// were kept have none and hold a single unit
+func (r *inventoryRepository) UpgradeOneInventory(pctx context.Context, playerId, inventoryId string, fromLevel, bonusDamage int) error {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.inventoryDbConnect(ctx)
+	col := db.Collection("players_inventory")
+
+	filter := bson.M{"_id": utils.ConvertToObjectId(inventoryId), "player_id": playerId, "locked_by": bson.M{"$exists": false}, "quantity": bson.M{"$in": bson.A{1, nil}}}
+	if fromLevel == 0 {
+		filter["upgrade_level"] = bson.M{"$exists": false}
+	} else {
+		filter["upgrade_level"] = fromLevel
+	}
+
+	result, err := col.UpdateOne(ctx, filter, bson.M{"$set": bson.M{"upgrade_level": fromLevel + 1, "bonus_damage": bonusDamage}})
+	if err != nil {
+		log.Printf("Error: UpgradeOneInventory failed: %s", err.Error())
+		return errors.New("error: upgrade inventory failed")
+	}
+	if result.ModifiedCount == 0 {
+		log.Printf("Error: UpgradeOneInventory failed: inventory %s is no longer at level %d", inventoryId, fromLevel)
+		return errors.New("error: item has changed, try again")
+	}
+
+	return nil
+}
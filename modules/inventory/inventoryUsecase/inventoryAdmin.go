@@ -0,0 +1,272 @@
+package inventoryUsecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Applessr/hello-sekai-shop-tutorial/config"
+	"github.com/Applessr/hello-sekai-shop-tutorial/modules/inventory"
+	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/utils"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// AdminGrantItems grants the units one by one, when one fails the units already granted stay
+// and the failure is reported next to them
+func (u *inventoryUsecase) AdminGrantItems(pctx context.Context, cfg *config.Config, adminId string, req *inventory.AdminGrantReq) (*inventory.AdminActionRes, error) {
+	itemData, err := u.findItem(pctx, cfg, req.ItemId)
+	if err != nil {
+		return nil, err
+	}
+	if itemData == nil {
+		return nil, errors.New("error: item not found")
+	}
+
+	res := &inventory.AdminActionRes{
+		PlayerId:     req.PlayerId,
+		ItemId:       req.ItemId,
+		InventoryIds: make([]string, 0),
+	}
+	for i := 0; i < req.Quantity; i++ {
+		inventoryId, err := u.grantItem(pctx, cfg, req.PlayerId, req.ItemId, &inventory.InventoryEvent{
+			Source:   "admin",
+			SourceId: adminId,
+			Reason:   req.Reason,
+		})
+		if err != nil {
+			if len(res.InventoryIds) == 0 {
+				return nil, err
+			}
+			res.Error = err.Error()
+			break
+		}
+		res.InventoryIds = append(res.InventoryIds, inventoryId)
+	}
+
+	return res, nil
+}
+
+// AdminRevokeItems takes units the same way a sale does, so locked and equipped entries are left alone
+func (u *inventoryUsecase) AdminRevokeItems(pctx context.Context, adminId string, req *inventory.AdminRevokeReq) (*inventory.AdminActionRes, error) {
+	res := &inventory.AdminActionRes{
+		PlayerId:     req.PlayerId,
+		ItemId:       req.ItemId,
+		InventoryIds: make([]string, 0),
+	}
+	for i := 0; i < req.Quantity; i++ {
+		entry, err := u.inventoryRepository.DeleteOnePlayerItem(pctx, req.PlayerId, req.ItemId)
+		if err != nil {
+			if len(res.InventoryIds) == 0 {
+				return nil, err
+			}
+			res.Error = err.Error()
+			break
+		}
+
+		u.recordEvent(pctx, &inventory.InventoryEvent{
+			Type:        "inventory.revoked",
+			PlayerId:    req.PlayerId,
+			InventoryId: entry.Id.Hex(),
+			ItemId:      req.ItemId,
+			Quantity:    -1,
+			Source:      "admin",
+			SourceId:    adminId,
+			Reason:      req.Reason,
+		})
+		res.InventoryIds = append(res.InventoryIds, entry.Id.Hex())
+	}
+
+	return res, nil
+}
+
+// AdminTransferItem moves a whole entry, stacks included, to another player
+func (u *inventoryUsecase) AdminTransferItem(pctx context.Context, cfg *config.Config, adminId string, req *inventory.AdminTransferReq) (*inventory.AdminActionRes, error) {
+	if req.FromPlayerId == req.ToPlayerId {
+		return nil, errors.New("error: can't transfer to the same player")
+	}
+
+	entry, err := u.inventoryRepository.FindOneInventory(pctx, req.InventoryId)
+	if err != nil || entry.PlayerId != req.FromPlayerId {
+		return nil, errors.New("error: inventory entry not found")
+	}
+	if entry.LockedBy != "" {
+		return nil, errors.New("error: item is locked by a trade")
+	}
+	if entry.EquippedSlot != "" {
+		return nil, errors.New("error: item is equipped")
+	}
+	if entry.ExpiresAt != nil && !entry.ExpiresAt.After(utils.LocalTime()) {
+		return nil, errors.New("error: item has expired")
+	}
+
+	capacity, err := u.inventoryRepository.FindOrInsertCapacity(pctx, req.ToPlayerId, cfg.Inventory.BaseCapacity)
+	if err != nil {
+		return nil, err
+	}
+	used, err := u.inventoryRepository.CountPlayerItems(pctx, req.ToPlayerId)
+	if err != nil {
+		return nil, err
+	}
+	if used+1 > capacity {
+		return nil, inventory.ErrInventoryFull
+	}
+
+	if err := u.inventoryRepository.TransferOnePlayerItem(pctx, req.InventoryId, req.FromPlayerId, req.ToPlayerId, ""); err != nil {
+		return nil, err
+	}
+
+	u.recordEvent(pctx, &inventory.InventoryEvent{
+		Type:        "inventory.transferred_out",
+		PlayerId:    req.FromPlayerId,
+		InventoryId: req.InventoryId,
+		ItemId:      entry.ItemId,
+		Quantity:    -entry.Quantity,
+		Source:      "admin",
+		SourceId:    adminId,
+		Reason:      req.Reason,
+	})
+	u.recordEvent(pctx, &inventory.InventoryEvent{
+		Type:        "inventory.transferred_in",
+		PlayerId:    req.ToPlayerId,
+		InventoryId: req.InventoryId,
+		ItemId:      entry.ItemId,
+		Quantity:    entry.Quantity,
+		Source:      "admin",
+		SourceId:    adminId,
+		Reason:      req.Reason,
+	})
+
+	return &inventory.AdminActionRes{
+		PlayerId:     req.ToPlayerId,
+		ItemId:       entry.ItemId,
+		InventoryIds: []string{req.InventoryId},
+	}, nil
+}
+
+func (u *inventoryUsecase) CreateBatchGrant(pctx context.Context, cfg *config.Config, adminId string, req *inventory.AdminBatchGrantReq) (*inventory.AdminJob, error) {
+	itemData, err := u.findItem(pctx, cfg, req.ItemId)
+	if err != nil {
+		return nil, err
+	}
+	if itemData == nil {
+		return nil, errors.New("error: item not found")
+	}
+
+	now := utils.LocalTime()
+	job := &inventory.AdminJob{
+		Type:        "batch_grant",
+		AdminId:     adminId,
+		OwnerItemId: req.OwnerItemId,
+		ItemId:      req.ItemId,
+		Quantity:    req.Quantity,
+		Reason:      req.Reason,
+		Status:      "pending",
+		Errors:      make([]string, 0),
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+
+	jobId, err := u.inventoryRepository.InsertOneAdminJob(pctx, job)
+	if err != nil {
+		return nil, err
+	}
+	job.Id = jobId
+
+	return job, nil
+}
+
+func (u *inventoryUsecase) FindAdminJob(pctx context.Context, jobId string) (*inventory.AdminJob, error) {
+	return u.inventoryRepository.FindOneAdminJob(pctx, jobId)
+}
+
+// RunAdminJobs works through every job waiting to run
+func (u *inventoryUsecase) RunAdminJobs(pctx context.Context, cfg *config.Config) {
+	for {
+		job, err := u.inventoryRepository.ClaimAdminJob(pctx)
+		if err != nil || job == nil {
+			return
+		}
+
+		u.runBatchGrant(pctx, cfg, job)
+	}
+}
+
+// runBatchGrant grants to the owners in player id order and saves its progress after every player.
+// Every unit is recorded under its own source id, so units granted before a crash or by a worker
+// that lost the job are not granted again
+func (u *inventoryUsecase) runBatchGrant(pctx context.Context, cfg *config.Config, job *inventory.AdminJob) {
+	jobId := job.Id.Hex()
+
+	if job.Cursor == "" {
+		total, err := u.inventoryRepository.CountItemOwners(pctx, job.OwnerItemId)
+		if err != nil {
+			return
+		}
+		if err := u.inventoryRepository.UpdateOneAdminJob(pctx, jobId, job.Lease, bson.M{"$set": bson.M{"total": total, "updated_at": utils.LocalTime()}}); err != nil {
+			return
+		}
+	}
+
+	cursor := job.Cursor
+	for {
+		owners, err := u.inventoryRepository.FindItemOwners(pctx, job.OwnerItemId, cursor, 100)
+		if err != nil {
+			// Left running, the job is taken over again once it goes quiet
+			return
+		}
+		if len(owners) == 0 {
+			break
+		}
+
+		for _, playerId := range owners {
+			// Checks the lease is still ours before granting anything to the player
+			if err := u.inventoryRepository.UpdateOneAdminJob(pctx, jobId, job.Lease, bson.M{"$set": bson.M{"updated_at": utils.LocalTime()}}); err != nil {
+				return
+			}
+
+			update := bson.M{
+				"$set": bson.M{"cursor": playerId, "updated_at": utils.LocalTime()},
+				"$inc": bson.M{"processed": 1, "succeeded": 1},
+			}
+
+			for i := 0; i < job.Quantity; i++ {
+				if err := u.grantJobUnit(pctx, cfg, job, playerId, i); err != nil {
+					update["$inc"] = bson.M{"processed": 1, "failed": 1}
+					update["$push"] = bson.M{"errors": bson.M{
+						"$each":  []string{fmt.Sprintf("%s: granted %d of %d, %s", playerId, i, job.Quantity, err.Error())},
+						"$slice": -100,
+					}}
+					break
+				}
+			}
+
+			if err := u.inventoryRepository.UpdateOneAdminJob(pctx, jobId, job.Lease, update); err != nil {
+				return
+			}
+			cursor = playerId
+		}
+	}
+
+	now := utils.LocalTime()
+	u.inventoryRepository.UpdateOneAdminJob(pctx, jobId, job.Lease, bson.M{"$set": bson.M{"status": "completed", "updated_at": now, "finished_at": now}})
+}
+
+// grantJobUnit grants the n-th unit of the job to the player unless its event shows it was granted already
+func (u *inventoryUsecase) grantJobUnit(pctx context.Context, cfg *config.Config, job *inventory.AdminJob, playerId string, n int) error {
+	sourceId := fmt.Sprintf("%s:%s:%d", job.Id.Hex(), playerId, n)
+
+	granted, err := u.inventoryRepository.CountInventoryEvents(pctx, bson.D{{"source", "admin_job"}, {"source_id", sourceId}})
+	if err != nil {
+		return err
+	}
+	if granted > 0 {
+		return nil
+	}
+
+	_, err = u.grantItem(pctx, cfg, playerId, job.ItemId, &inventory.InventoryEvent{
+		Source:   "admin_job",
+		SourceId: sourceId,
+		Reason:   job.Reason,
+	})
+	return err
+}
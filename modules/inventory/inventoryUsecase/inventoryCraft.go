@@ -0,0 +1,291 @@
+package inventoryUsecase
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/Applessr/hello-sekai-shop-tutorial/config"
+	"github.com/Applessr/hello-sekai-shop-tutorial/modules/inventory"
+	itemPb "github.com/Applessr/hello-sekai-shop-tutorial/modules/item/itemPb"
+	playerPb "github.com/Applessr/hello-sekai-shop-tutorial/modules/player/playerPb"
+	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/gacha"
+	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/utils"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// CraftItem runs the recipe as a saga: take the inputs, charge the coins, then grant the outputs.
+// A failing step undoes the ones before it, so the player ends up either crafted or unchanged.
+// Every step is saved on the craft first, so a craft cut short is finished or undone by RecoverCrafts
+func (u *inventoryUsecase) CraftItem(pctx context.Context, cfg *config.Config, playerId string, req *inventory.CraftItemReq) (*inventory.CraftItemRes, error) {
+	recipe, err := u.inventoryRepository.FindRecipe(pctx, cfg.Grpc.ItemUrl, &itemPb.GetRecipeReq{Id: req.RecipeId})
+	if err != nil {
+		return nil, err
+	}
+
+	// Counted the way DeleteOnePlayerItem takes them, equipped and locked entries are left alone
+	itemIds := make([]string, 0)
+	for _, v := range recipe.Inputs {
+		itemIds = append(itemIds, v.ItemId)
+	}
+	counts, err := u.inventoryRepository.CountPlayerItemsInIds(pctx, playerId, itemIds, true, true)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range recipe.Inputs {
+		if counts[v.ItemId] < int64(v.Quantity) {
+			return nil, errors.New("error: not enough items to craft")
+		}
+	}
+
+	now := utils.LocalTime()
+	craft := &inventory.Craft{
+		PlayerId:       playerId,
+		RecipeId:       recipe.Id,
+		OutputItemId:   recipe.OutputItemId,
+		OutputQuantity: int(recipe.OutputQuantity),
+		Status:         "consuming",
+		Consumed:       make([]string, 0),
+		Granted:        make([]string, 0),
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	craftId, err := u.inventoryRepository.InsertOneCraft(pctx, craft)
+	if err != nil {
+		return nil, err
+	}
+	craft.Id = craftId
+	origin := craftOrigin(craft)
+
+	// Stage 1: take the inputs, this also frees the slots the outputs may need
+	for _, v := range recipe.Inputs {
+		for i := 0; i < int(v.Quantity); i++ {
+			entry, err := u.inventoryRepository.DeleteOnePlayerItem(pctx, playerId, v.ItemId)
+			if err != nil {
+				u.compensateCraft(pctx, cfg, craft)
+				return nil, errors.New("error: not enough items to craft")
+			}
+			craft.Consumed = append(craft.Consumed, v.ItemId)
+			u.inventoryRepository.UpdateOneCraft(pctx, craftId.Hex(), bson.M{
+				"$push": bson.M{"consumed": v.ItemId},
+				"$set":  bson.M{"updated_at": utils.LocalTime()},
+			})
+
+			u.recordEvent(pctx, &inventory.InventoryEvent{
+				Type:        "inventory.consumed",
+				PlayerId:    playerId,
+				InventoryId: entry.Id.Hex(),
+				ItemId:      v.ItemId,
+				Quantity:    -1,
+				Source:      origin.Source,
+				SourceId:    origin.SourceId,
+				Entry:       removedEntry(entry),
+			})
+		}
+	}
+
+	// Stage 2: charge the coins
+	if recipe.Coins > 0 {
+		u.setCraftStatus(pctx, craft, "charging", nil)
+
+		debit, err := u.inventoryRepository.CreatePlayerTransaction(pctx, cfg.Grpc.PlayerUrl, &playerPb.CreatePlayerTransactionReq{
+			PlayerId: playerId,
+			Amount:   -recipe.Coins,
+		})
+		if err != nil {
+			u.compensateCraft(pctx, cfg, craft)
+			return nil, errors.New("error: craft coins payment failed")
+		}
+		craft.TransactionId = debit.TransactionId
+		u.inventoryRepository.UpdateOneCraft(pctx, craftId.Hex(), bson.M{
+			"$set": bson.M{"transaction_id": debit.TransactionId, "updated_at": utils.LocalTime()},
+		})
+	}
+
+	// Stage 3: grant the outputs
+	u.setCraftStatus(pctx, craft, "granting", nil)
+	if err := u.grantCraftOutputs(pctx, cfg, craft); err != nil {
+		u.compensateCraft(pctx, cfg, craft)
+		return nil, err
+	}
+	u.setCraftStatus(pctx, craft, "completed", nil)
+
+	return &inventory.CraftItemRes{
+		CraftId:      craftId.Hex(),
+		RecipeId:     recipe.Id,
+		ItemId:       recipe.OutputItemId,
+		InventoryIds: craft.Granted,
+	}, nil
+}
+
+func craftOrigin(craft *inventory.Craft) *inventory.InventoryEvent {
+	return &inventory.InventoryEvent{
+		Source:   "craft",
+		SourceId: craft.Id.Hex(),
+	}
+}
+
+func (u *inventoryUsecase) setCraftStatus(pctx context.Context, craft *inventory.Craft, status string, set bson.M) {
+	craft.Status = status
+	if set == nil {
+		set = bson.M{}
+	}
+	set["status"] = status
+	set["updated_at"] = utils.LocalTime()
+	u.inventoryRepository.UpdateOneCraft(pctx, craft.Id.Hex(), bson.M{"$set": set})
+}
+
+// grantCraftOutputs grants whatever outputs the craft is still missing
+func (u *inventoryUsecase) grantCraftOutputs(pctx context.Context, cfg *config.Config, craft *inventory.Craft) error {
+	for len(craft.Granted) < craft.OutputQuantity {
+		inventoryId, err := u.grantItem(pctx, cfg, craft.PlayerId, craft.OutputItemId, craftOrigin(craft))
+		if err != nil {
+			return err
+		}
+		craft.Granted = append(craft.Granted, inventoryId)
+		u.inventoryRepository.UpdateOneCraft(pctx, craft.Id.Hex(), bson.M{
+			"$push": bson.M{"granted": inventoryId},
+			"$set":  bson.M{"updated_at": utils.LocalTime()},
+		})
+	}
+	return nil
+}
+
+// compensateCraft undoes the craft newest step first and takes every undone step off the craft,
+// a step that fails leaves the craft compensating so RecoverCrafts retries from there
+func (u *inventoryUsecase) compensateCraft(pctx context.Context, cfg *config.Config, craft *inventory.Craft) {
+	craftId := craft.Id.Hex()
+	origin := craftOrigin(craft)
+
+	u.setCraftStatus(pctx, craft, "compensating", nil)
+
+	for len(craft.Granted) > 0 {
+		inventoryId := craft.Granted[len(craft.Granted)-1]
+		if err := u.revertGrant(pctx, cfg, inventoryId, origin); err != nil {
+			log.Printf("Error: compensateCraft failed: craft %s revert grant %s: %s", craftId, inventoryId, err.Error())
+			return
+		}
+		craft.Granted = craft.Granted[:len(craft.Granted)-1]
+		u.inventoryRepository.UpdateOneCraft(pctx, craftId, bson.M{"$pop": bson.M{"granted": 1}, "$set": bson.M{"updated_at": utils.LocalTime()}})
+	}
+
+	if craft.TransactionId != "" {
+		if err := u.inventoryRepository.RollbackPlayerTransaction(pctx, cfg.Grpc.PlayerUrl, &playerPb.RollbackPlayerTransactionReq{
+			TransactionId: craft.TransactionId,
+		}); err != nil {
+			log.Printf("Error: compensateCraft failed: craft %s rollback transaction %s: %s", craftId, craft.TransactionId, err.Error())
+			return
+		}
+		craft.TransactionId = ""
+		u.inventoryRepository.UpdateOneCraft(pctx, craftId, bson.M{"$unset": bson.M{"transaction_id": ""}, "$set": bson.M{"updated_at": utils.LocalTime()}})
+	}
+
+	for len(craft.Consumed) > 0 {
+		itemId := craft.Consumed[len(craft.Consumed)-1]
+		if err := u.restoreItem(pctx, cfg, craft.PlayerId, itemId, origin); err != nil {
+			log.Printf("Error: compensateCraft failed: craft %s restore %s: %s", craftId, itemId, err.Error())
+			return
+		}
+		craft.Consumed = craft.Consumed[:len(craft.Consumed)-1]
+		u.inventoryRepository.UpdateOneCraft(pctx, craftId, bson.M{"$pop": bson.M{"consumed": 1}, "$set": bson.M{"updated_at": utils.LocalTime()}})
+	}
+
+	u.setCraftStatus(pctx, craft, "failed", nil)
+}
+
+// RecoverCrafts picks up crafts whose worker stopped. One that was granting has taken everything
+// already and is finished, any other is undone
+func (u *inventoryUsecase) RecoverCrafts(pctx context.Context, cfg *config.Config) {
+	for {
+		craft, err := u.inventoryRepository.ClaimStuckCraft(pctx)
+		if err != nil || craft == nil {
+			return
+		}
+
+		if craft.Status == "granting" {
+			if err := u.grantCraftOutputs(pctx, cfg, craft); err == nil {
+				u.setCraftStatus(pctx, craft, "completed", nil)
+				continue
+			}
+		}
+		u.compensateCraft(pctx, cfg, craft)
+	}
+}
+
+// UpgradeItem charges the tier cost and rolls against its success rate, the coins are kept
+// when the roll fails
+func (u *inventoryUsecase) UpgradeItem(pctx context.Context, cfg *config.Config, playerId string, req *inventory.UpgradeItemReq) (*inventory.UpgradeItemRes, error) {
+	entry, err := u.inventoryRepository.FindOneInventory(pctx, req.InventoryId)
+	if err != nil || entry.PlayerId != playerId {
+		return nil, errors.New("error: inventory entry not found")
+	}
+	if entry.LockedBy != "" {
+		return nil, errors.New("error: item is locked by a trade")
+	}
+	if entry.ExpiresAt != nil && !entry.ExpiresAt.After(utils.LocalTime()) {
+		return nil, errors.New("error: item has expired")
+	}
+	// Entries saved before quantities were kept hold a single unit
+	if entry.Quantity > 1 {
+		return nil, errors.New("error: stacked items can't be upgraded")
+	}
+
+	path, err := u.inventoryRepository.FindUpgradePath(pctx, cfg.Grpc.ItemUrl, &itemPb.GetUpgradePathReq{Id: entry.ItemId})
+	if err != nil {
+		return nil, err
+	}
+	if entry.UpgradeLevel >= len(path.Tiers) {
+		return nil, errors.New("error: item is at its highest level")
+	}
+	tier := path.Tiers[entry.UpgradeLevel]
+
+	transactionId := ""
+	if tier.Cost > 0 {
+		debit, err := u.inventoryRepository.CreatePlayerTransaction(pctx, cfg.Grpc.PlayerUrl, &playerPb.CreatePlayerTransactionReq{
+			PlayerId: playerId,
+			Amount:   -tier.Cost,
+		})
+		if err != nil {
+			return nil, errors.New("error: upgrade coins payment failed")
+		}
+		transactionId = debit.TransactionId
+	}
+
+	res := &inventory.UpgradeItemRes{
+		InventoryId:  req.InventoryId,
+		ItemId:       entry.ItemId,
+		UpgradeLevel: entry.UpgradeLevel,
+		BonusDamage:  entry.BonusDamage,
+		Cost:         tier.Cost,
+	}
+
+	if gacha.Roll(gacha.NewServerSeed(), req.InventoryId, int64(entry.UpgradeLevel)) < tier.SuccessRate {
+		bonusDamage := entry.BonusDamage + int(tier.Damage)
+		if err := u.inventoryRepository.UpgradeOneInventory(pctx, playerId, req.InventoryId, entry.UpgradeLevel, bonusDamage); err != nil {
+			if transactionId != "" {
+				u.inventoryRepository.RollbackPlayerTransaction(pctx, cfg.Grpc.PlayerUrl, &playerPb.RollbackPlayerTransactionReq{
+					TransactionId: transactionId,
+				})
+			}
+			return nil, err
+		}
+		res.Success = true
+		res.UpgradeLevel++
+		res.BonusDamage = bonusDamage
+	}
+
+	eventType := "inventory.upgrade_failed"
+	if res.Success {
+		eventType = "inventory.upgraded"
+	}
+	u.recordEvent(pctx, &inventory.InventoryEvent{
+		Type:        eventType,
+		PlayerId:    playerId,
+		InventoryId: req.InventoryId,
+		ItemId:      entry.ItemId,
+		Source:      "upgrade",
+		SourceId:    transactionId,
+	})
+
+	return res, nil
+}
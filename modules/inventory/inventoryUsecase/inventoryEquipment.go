@@ -0,0 +1,126 @@
+package inventoryUsecase
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/Applessr/hello-sekai-shop-tutorial/config"
+	"github.com/Applessr/hello-sekai-shop-tutorial/modules/inventory"
+	inventoryPb "github.com/Applessr/hello-sekai-shop-tutorial/modules/inventory/inventoryPb"
+	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/utils"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (u *inventoryUsecase) EquipItem(pctx context.Context, cfg *config.Config, playerId string, req *inventory.EquipItemReq) (*inventory.LoadoutRes, error) {
+	equipType, ok := inventory.EquipmentSlots[req.Slot]
+	if !ok {
+		return nil, errors.New("error: unknown equipment slot")
+	}
+
+	entry, err := u.inventoryRepository.FindOneInventory(pctx, req.InventoryId)
+	if err != nil || entry.PlayerId != playerId {
+		return nil, errors.New("error: inventory entry not found")
+	}
+	if entry.LockedBy != "" {
+		return nil, errors.New("error: item is locked by a trade")
+	}
+	if entry.Quantity > 1 {
+		return nil, errors.New("error: a stack can't be equipped")
+	}
+	if entry.ExpiresAt != nil && !entry.ExpiresAt.After(utils.LocalTime()) {
+		return nil, errors.New("error: item has expired")
+	}
+
+	itemData, err := u.findItem(pctx, cfg, entry.ItemId)
+	if err != nil {
+		return nil, err
+	}
+	if itemData == nil {
+		return nil, errors.New("error: item not found")
+	}
+	if itemData.EquipType != equipType {
+		return nil, errors.New("error: item does not fit this slot")
+	}
+
+	if err := u.inventoryRepository.EquipOnePlayerItem(pctx, playerId, req.InventoryId, req.Slot); err != nil {
+		return nil, err
+	}
+
+	return u.FindLoadout(pctx, cfg, playerId)
+}
+
+func (u *inventoryUsecase) UnequipItem(pctx context.Context, cfg *config.Config, playerId string, req *inventory.UnequipItemReq) (*inventory.LoadoutRes, error) {
+	if _, ok := inventory.EquipmentSlots[req.Slot]; !ok {
+		return nil, errors.New("error: unknown equipment slot")
+	}
+
+	if err := u.inventoryRepository.UnequipPlayerSlot(pctx, playerId, req.Slot); err != nil {
+		return nil, err
+	}
+
+	return u.FindLoadout(pctx, cfg, playerId)
+}
+
+func (u *inventoryUsecase) FindLoadout(pctx context.Context, cfg *config.Config, playerId string) (*inventory.LoadoutRes, error) {
+	entries, err := u.inventoryRepository.FindPlayerItems(pctx, bson.D{
+		{"player_id", playerId},
+		{"equipped_slot", bson.D{{"$exists", true}}},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &inventory.LoadoutRes{
+		PlayerId: playerId,
+		Slots:    make([]*inventory.EquippedItem, 0),
+	}
+	if len(entries) == 0 {
+		return res, nil
+	}
+
+	itemMaps, reachable := u.findLiveItems(pctx, cfg, entries)
+	res.Degraded = !reachable
+
+	for _, v := range entries {
+		live := itemMaps[v.ItemId]
+		res.Slots = append(res.Slots, &inventory.EquippedItem{
+			Slot:         v.EquippedSlot,
+			InventoryId:  v.Id.Hex(),
+			Retired:      reachable && live == nil,
+			ItemShowCase: itemShowCaseOf(v, live),
+		})
+	}
+	sort.Slice(res.Slots, func(i, j int) bool { return res.Slots[i].Slot < res.Slots[j].Slot })
+
+	return res, nil
+}
+
+func (u *inventoryUsecase) GetLoadout(pctx context.Context, cfg *config.Config, req *inventoryPb.GetLoadoutReq) (*inventoryPb.Loadout, error) {
+	if req.PlayerId == "" {
+		return nil, errors.New("error: player id is required")
+	}
+
+	loadout, err := u.FindLoadout(pctx, cfg, req.PlayerId)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &inventoryPb.Loadout{
+		PlayerId: loadout.PlayerId,
+		Slots:    make([]*inventoryPb.LoadoutSlot, 0),
+	}
+	for _, v := range loadout.Slots {
+		res.Slots = append(res.Slots, &inventoryPb.LoadoutSlot{
+			Slot:        v.Slot,
+			InventoryId: v.InventoryId,
+			ItemId:      v.ItemId,
+			Title:       v.Title,
+			Damage:      int32(v.Damage),
+			Rarity:      v.Rarity,
+			ImageUrl:    v.ImageUrl,
+		})
+	}
+
+	return res, nil
+}
@@ -0,0 +1,138 @@
+package inventoryUsecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/Applessr/hello-sekai-shop-tutorial/config"
+	"github.com/Applessr/hello-sekai-shop-tutorial/modules/inventory"
+	"github.com/Applessr/hello-sekai-shop-tutorial/modules/models"
+	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// recordEvent appends the event to the player's history right after the change it describes.
+// The write is retried under the same event id, an event that still can't be stored is logged
+// in full so it can be replayed, it never undoes the change
+func (u *inventoryUsecase) recordEvent(pctx context.Context, req *inventory.InventoryEvent) *inventory.InventoryEvent {
+	if req.EventId == "" {
+		req.EventId = primitive.NewObjectID().Hex()
+	}
+	if req.OccurredAt.IsZero() {
+		req.OccurredAt = utils.LocalTime()
+	}
+
+	for attempt := 1; attempt <= recordEventAttempts; attempt++ {
+		if err := u.inventoryRepository.InsertOneInventoryEvent(pctx, req); err == nil {
+			return req
+		}
+		time.Sleep(time.Duration(attempt) * 100 * time.Millisecond)
+	}
+
+	b, _ := json.Marshal(req)
+	log.Printf("Error: recordEvent failed: gave up after %d attempts: %s", recordEventAttempts, string(b))
+	return req
+}
+
+const recordEventAttempts = 3
+
+func (u *inventoryUsecase) FindPlayerItemHistory(pctx context.Context, cfg *config.Config, playerId string, req *inventory.InventoryHistoryReq) (*models.PaginateRes, error) {
+	filter := bson.D{{"player_id", playerId}}
+	if req.ItemId != "" {
+		filter = append(filter, bson.E{"item_id", req.ItemId})
+	}
+
+	total, err := u.inventoryRepository.CountInventoryEvents(pctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Newest first, event ids are object ids so they sort by time
+	if req.Start != "" {
+		filter = append(filter, bson.E{"_id", bson.D{{"$lt", req.Start}}})
+	}
+
+	opts := make([]*options.FindOptions, 0)
+	opts = append(opts, options.Find().SetSort(bson.D{{"_id", -1}}))
+	opts = append(opts, options.Find().SetLimit(int64(req.Limit)))
+
+	results, err := u.inventoryRepository.FindInventoryEvents(pctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, _ := time.LoadLocation("Asia/Bangkok")
+	for _, v := range results {
+		v.OccurredAt = v.OccurredAt.In(loc)
+	}
+
+	query := fmt.Sprintf("limit=%d", req.Limit)
+	if req.ItemId != "" {
+		query = fmt.Sprintf("%s&item_id=%s", query, req.ItemId)
+	}
+
+	res := &models.PaginateRes{
+		Data:  results,
+		Total: total,
+		Limit: req.Limit,
+		First: models.FirstPaginate{
+			Href: fmt.Sprintf("%s/%s/history?%s", cfg.Paginate.InventoryNextPageBasedUrl, playerId, query),
+		},
+		Next: models.NextPaginate{
+			Start: "",
+			Href:  "",
+		},
+	}
+	if len(results) > 0 {
+		res.Next = models.NextPaginate{
+			Start: results[len(results)-1].EventId,
+			Href:  fmt.Sprintf("%s/%s/history?%s&start=%s", cfg.Paginate.InventoryNextPageBasedUrl, playerId, query, results[len(results)-1].EventId),
+		}
+	}
+
+	return res, nil
+}
+
+// RebuildPlayerInventory replays the player's history up to the given moment, now when it is left out
+func (u *inventoryUsecase) RebuildPlayerInventory(pctx context.Context, playerId string, req *inventory.InventorySnapshotReq) (*inventory.InventorySnapshotRes, error) {
+	at := utils.LocalTime()
+	if req.At != "" {
+		parsed, err := time.Parse(time.RFC3339, req.At)
+		if err != nil {
+			return nil, errors.New("error: at must be an RFC3339 time")
+		}
+		at = parsed
+	}
+
+	sums, err := u.inventoryRepository.SumInventoryEvents(pctx, playerId, at)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*inventory.InventorySnapshotItem, 0)
+	for itemId, quantity := range sums {
+		if quantity <= 0 {
+			continue
+		}
+		items = append(items, &inventory.InventorySnapshotItem{
+			ItemId:   itemId,
+			Quantity: quantity,
+		})
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].ItemId < items[j].ItemId })
+
+	loc, _ := time.LoadLocation("Asia/Bangkok")
+
+	return &inventory.InventorySnapshotRes{
+		PlayerId: playerId,
+		At:       at.In(loc),
+		Items:    items,
+	}, nil
+}
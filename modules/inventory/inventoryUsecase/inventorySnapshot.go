@@ -0,0 +1,155 @@
+package inventoryUsecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/url"
+
+	"github.com/Applessr/hello-sekai-shop-tutorial/config"
+	"github.com/Applessr/hello-sekai-shop-tutorial/modules/inventory"
+	"github.com/Applessr/hello-sekai-shop-tutorial/modules/item"
+	itemPb "github.com/Applessr/hello-sekai-shop-tutorial/modules/item/itemPb"
+	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/utils"
+)
+
+func snapshotFromPb(v *itemPb.Item) *inventory.ItemSnapshot {
+	return &inventory.ItemSnapshot{
+		Title:     v.Title,
+		Category:  v.Category,
+		Rarity:    v.Rarity,
+		Price:     v.Price,
+		Damage:    int(v.Damage),
+		ImageUrl:  v.ImageUrl,
+		EquipType: v.EquipType,
+		Version:   v.Version,
+		SyncedAt:  utils.LocalTime(),
+	}
+}
+
+func snapshotFromItem(v *item.Item) *inventory.ItemSnapshot {
+	return &inventory.ItemSnapshot{
+		Title:     v.Title,
+		Category:  v.Category,
+		Rarity:    v.Rarity,
+		Price:     item.EffectivePrice(v.Price, v.PriceSchedules, utils.LocalTime()),
+		Damage:    v.Damage,
+		ImageUrl:  v.ImageUrl,
+		EquipType: v.EquipType,
+		Version:   v.Version,
+		SyncedAt:  utils.LocalTime(),
+	}
+}
+
+// itemShowCaseOf prefers the live item and falls back to the snapshot kept on the entry,
+// an entry with neither only shows its item id
+func itemShowCaseOf(v *inventory.Inventory, live *itemPb.Item) *item.ItemShowCase {
+	showCase := &item.ItemShowCase{ItemId: v.ItemId}
+
+	switch {
+	case live != nil:
+		showCase.Title = live.Title
+		showCase.Price = live.Price
+		showCase.Damage = int(live.Damage)
+		showCase.ImageUrl = live.ImageUrl
+		showCase.Category = live.Category
+		showCase.Rarity = live.Rarity
+		showCase.EquipType = live.EquipType
+		showCase.Version = live.Version
+	case v.Item != nil:
+		showCase.Title = v.Item.Title
+		showCase.Price = v.Item.Price
+		showCase.Damage = v.Item.Damage
+		showCase.ImageUrl = v.Item.ImageUrl
+		showCase.Category = v.Item.Category
+		showCase.Rarity = v.Item.Rarity
+		showCase.EquipType = v.Item.EquipType
+		showCase.Version = v.Item.Version
+	}
+	showCase.Damage += v.BonusDamage
+
+	return showCase
+}
+
+// findLiveItems looks up the entries' items, reachable is false when the item service could not
+// answer, an item missing from a reachable answer has been disabled or deleted
+func (u *inventoryUsecase) findLiveItems(pctx context.Context, cfg *config.Config, entries []*inventory.Inventory) (map[string]*itemPb.Item, bool) {
+	itemIds := make([]string, 0)
+	for _, v := range entries {
+		itemIds = append(itemIds, v.ItemId)
+	}
+
+	itemMaps := make(map[string]*itemPb.Item)
+
+	itemData, err := u.inventoryRepository.FindItemInIds(pctx, cfg.Grpc.ItemUrl, &itemPb.FindItemInIdsReq{
+		Ids: itemIds,
+	})
+	if err != nil {
+		return itemMaps, false
+	}
+
+	for _, v := range itemData.Items {
+		itemMaps[v.Id] = v
+	}
+	return itemMaps, true
+}
+
+// inventorySearchQuery rebuilds the query string of a search so the page links keep its filters
+func inventorySearchQuery(req *inventory.InventorySearchReq) url.Values {
+	query := url.Values{}
+	query.Set("limit", fmt.Sprint(req.Limit))
+	for k, v := range map[string]string{
+		"title":    req.Title,
+		"category": req.Category,
+		"rarity":   req.Rarity,
+		"equipped": req.Equipped,
+		"sort":     req.Sort,
+		"order":    req.Order,
+	} {
+		if v != "" {
+			query.Set(k, v)
+		}
+	}
+	return query
+}
+
+// RefreshSnapshots catches up the snapshots the item events missed, entries granted before
+// snapshots existed or whose item changed while the consumer was down. Filters and sorts run on
+// snapshots, so until then those entries can land on the wrong page
+func (u *inventoryUsecase) RefreshSnapshots(pctx context.Context, cfg *config.Config) {
+	versions, err := u.inventoryRepository.FindSnapshotVersions(pctx)
+	if err != nil || len(versions) == 0 {
+		return
+	}
+
+	itemIds := make([]string, 0)
+	for itemId := range versions {
+		itemIds = append(itemIds, itemId)
+	}
+
+	for len(itemIds) > 0 {
+		batch := itemIds[:min(len(itemIds), 100)]
+		itemIds = itemIds[len(batch):]
+
+		itemData, err := u.inventoryRepository.FindItemInIds(pctx, cfg.Grpc.ItemUrl, &itemPb.FindItemInIdsReq{
+			Ids: batch,
+		})
+		if err != nil {
+			log.Printf("Error: RefreshSnapshots failed: %s", err.Error())
+			return
+		}
+
+		for _, v := range itemData.Items {
+			if version, ok := versions[v.Id]; ok && version < v.Version {
+				u.inventoryRepository.UpdateItemSnapshots(pctx, v.Id, snapshotFromPb(v))
+			}
+		}
+	}
+}
+
+func (u *inventoryUsecase) SyncItemSnapshot(pctx context.Context, req *item.ItemEvent) {
+	if req.Item == nil {
+		return
+	}
+	u.inventoryRepository.UpdateItemSnapshots(pctx, req.ItemId, snapshotFromItem(req.Item))
+}
@@ -2,17 +2,25 @@ package inventoryUsecase
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
 
 	"github.com/Applessr/hello-sekai-shop-tutorial/config"
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/inventory"
+	inventoryPb "github.com/Applessr/hello-sekai-shop-tutorial/modules/inventory/inventoryPb"
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/inventory/inventoryRepository"
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/item"
 	itemPb "github.com/Applessr/hello-sekai-shop-tutorial/modules/item/itemPb"
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/models"
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/payment"
+	playerPb "github.com/Applessr/hello-sekai-shop-tutorial/modules/player/playerPb"
 	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/utils"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
@@ -25,6 +33,36 @@ type (
 		RemovePlayerItemRes(pctx context.Context, cfg *config.Config, req *inventory.UpdateInventoryReq)
 		RollbackAddPlayerItem(pctx context.Context, cfg *config.Config, req *inventory.RollbackPlayerInventoryReq)
 		RollbackRemovePlayerItem(pctx context.Context, cfg *config.Config, req *inventory.RollbackPlayerInventoryReq)
+		FindPlayerCapacity(pctx context.Context, cfg *config.Config, playerId string) (*inventory.InventoryCapacityRes, error)
+		CreateTrade(pctx context.Context, cfg *config.Config, playerId string, req *inventory.CreateTradeReq) (*inventory.TradeShowCase, error)
+		FindOneTrade(pctx context.Context, playerId, tradeId string) (*inventory.TradeShowCase, error)
+		UpdateTradeOffer(pctx context.Context, cfg *config.Config, playerId, tradeId string, req *inventory.UpdateTradeOfferReq) (*inventory.TradeShowCase, error)
+		ConfirmTrade(pctx context.Context, cfg *config.Config, playerId, tradeId string) (*inventory.TradeShowCase, error)
+		CancelTrade(pctx context.Context, playerId, tradeId string) (*inventory.TradeShowCase, error)
+		ExpireTrades(pctx context.Context, cfg *config.Config)
+		ExpireItems(pctx context.Context, cfg *config.Config)
+		FindPlayerItemHistory(pctx context.Context, cfg *config.Config, playerId string, req *inventory.InventoryHistoryReq) (*models.PaginateRes, error)
+		RebuildPlayerInventory(pctx context.Context, playerId string, req *inventory.InventorySnapshotReq) (*inventory.InventorySnapshotRes, error)
+		AdminGrantItems(pctx context.Context, cfg *config.Config, adminId string, req *inventory.AdminGrantReq) (*inventory.AdminActionRes, error)
+		AdminRevokeItems(pctx context.Context, adminId string, req *inventory.AdminRevokeReq) (*inventory.AdminActionRes, error)
+		AdminTransferItem(pctx context.Context, cfg *config.Config, adminId string, req *inventory.AdminTransferReq) (*inventory.AdminActionRes, error)
+		CreateBatchGrant(pctx context.Context, cfg *config.Config, adminId string, req *inventory.AdminBatchGrantReq) (*inventory.AdminJob, error)
+		FindAdminJob(pctx context.Context, jobId string) (*inventory.AdminJob, error)
+		RunAdminJobs(pctx context.Context, cfg *config.Config)
+		RecoverCrafts(pctx context.Context, cfg *config.Config)
+		RefreshSnapshots(pctx context.Context, cfg *config.Config)
+		SyncItemSnapshot(pctx context.Context, req *item.ItemEvent)
+		HasItems(pctx context.Context, req *inventoryPb.HasItemsReq) (*inventoryPb.HasItemsRes, error)
+		CountPlayerItems(pctx context.Context, req *inventoryPb.CountPlayerItemsReq) (*inventoryPb.CountPlayerItemsRes, error)
+		ListPlayerItems(pctx context.Context, req *inventoryPb.ListPlayerItemsReq) (*inventoryPb.ListPlayerItemsRes, error)
+		GetInventoryEntry(pctx context.Context, req *inventoryPb.GetInventoryEntryReq) (*inventoryPb.InventoryEntry, error)
+		GetInventoryEntries(pctx context.Context, req *inventoryPb.GetInventoryEntriesReq) (*inventoryPb.GetInventoryEntriesRes, error)
+		EquipItem(pctx context.Context, cfg *config.Config, playerId string, req *inventory.EquipItemReq) (*inventory.LoadoutRes, error)
+		UnequipItem(pctx context.Context, cfg *config.Config, playerId string, req *inventory.UnequipItemReq) (*inventory.LoadoutRes, error)
+		FindLoadout(pctx context.Context, cfg *config.Config, playerId string) (*inventory.LoadoutRes, error)
+		GetLoadout(pctx context.Context, cfg *config.Config, req *inventoryPb.GetLoadoutReq) (*inventoryPb.Loadout, error)
+		CraftItem(pctx context.Context, cfg *config.Config, playerId string, req *inventory.CraftItemReq) (*inventory.CraftItemRes, error)
+		UpgradeItem(pctx context.Context, cfg *config.Config, playerId string, req *inventory.UpgradeItemReq) (*inventory.UpgradeItemRes, error)
 	}
 
 	inventoryUsecase struct {
@@ -45,20 +83,80 @@ func (u *inventoryUsecase) UpserOffset(pctx context.Context, offset int64) error
 
 func (u *inventoryUsecase) FindPlayerItems(pctx context.Context, cfg *config.Config, playerId string, req *inventory.InventorySearchReq) (*models.PaginateRes, error) {
 	// Filter
-	filter := bson.D{}
+	filter := bson.D{{"player_id", playerId}}
 
-	// Filter
+	if req.Title != "" {
+		filter = append(filter, bson.E{"item.title", primitive.Regex{Pattern: regexp.QuoteMeta(req.Title), Options: "i"}})
+	}
+	if req.Category != "" {
+		filter = append(filter, bson.E{"item.category", req.Category})
+	}
+	if req.Rarity != "" {
+		filter = append(filter, bson.E{"item.rarity", req.Rarity})
+	}
+	if req.Equipped != "" {
+		filter = append(filter, bson.E{"equipped_slot", bson.D{{"$exists", req.Equipped == "true"}}})
+	}
+
+	// Count
+	total, err := u.inventoryRepository.CountPlayerItemsByFilter(pctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Sort, the acquired order is the _id order
+	sortField := "_id"
+	switch req.Sort {
+	case "price":
+		sortField = "item.price"
+	case "damage":
+		sortField = "item.damage"
+	}
+	direction, op := 1, "$gt"
+	if req.Order == "desc" {
+		direction, op = -1, "$lt"
+	}
+
+	// Pages continue after the start entry, ties on the sort field are broken by _id
 	if req.Start != "" {
-		filter = append(filter, bson.E{"_id", bson.D{{"$gt", utils.ConvertToObjectId(req.Start)}}})
+		if sortField == "_id" {
+			filter = append(filter, bson.E{"_id", bson.D{{op, utils.ConvertToObjectId(req.Start)}}})
+		} else {
+			last, err := u.inventoryRepository.FindOneInventory(pctx, req.Start)
+			if err != nil || last.PlayerId != playerId {
+				return nil, errors.New("error: start not found")
+			}
+
+			if last.Item == nil {
+				// Entries without a snapshot sort before every value, so ascending pages go on to
+				// the rest of them and then to every entry that has one
+				after := bson.A{bson.D{{sortField, nil}, {"_id", bson.D{{op, last.Id}}}}}
+				if direction == 1 {
+					after = append(after, bson.D{{sortField, bson.D{{"$ne", nil}}}})
+				}
+				filter = append(filter, bson.E{"$or", after})
+			} else {
+				var value any = last.Item.Damage
+				if sortField == "item.price" {
+					value = last.Item.Price
+				}
+
+				filter = append(filter, bson.E{"$or", bson.A{
+					bson.D{{sortField, bson.D{{op, value}}}},
+					bson.D{{sortField, value}, {"_id", bson.D{{op, last.Id}}}},
+				}})
+			}
+		}
 	}
-	filter = append(filter, bson.E{"player_id", playerId})
 
 	// Option
 	opts := make([]*options.FindOptions, 0)
 
-	opts = append(opts, options.Find().SetSort(bson.D{{"_id", 1}}))
+	opts = append(opts, options.Find().SetSort(bson.D{{sortField, direction}, {"_id", direction}}))
 	opts = append(opts, options.Find().SetLimit(int64(req.Limit)))
 
+	query := inventorySearchQuery(req)
+
 	// Find
 	inventoryData, err := u.inventoryRepository.FindPlayerItems(pctx, filter, opts)
 	if err != nil {
@@ -67,10 +165,10 @@ func (u *inventoryUsecase) FindPlayerItems(pctx context.Context, cfg *config.Con
 	if len(inventoryData) == 0 {
 		return &models.PaginateRes{
 			Data:  make([]*inventory.ItemInInventory, 0),
-			Total: 0,
+			Total: total,
 			Limit: req.Limit,
 			First: models.FirstPaginate{
-				Href: fmt.Sprintf("%s/%s?limit=%d", cfg.Paginate.InventoryNextPageBasedUrl, playerId, req.Limit),
+				Href: fmt.Sprintf("%s/%s?%s", cfg.Paginate.InventoryNextPageBasedUrl, playerId, query.Encode()),
 			},
 			Next: models.NextPaginate{
 				Start: "",
@@ -79,66 +177,146 @@ func (u *inventoryUsecase) FindPlayerItems(pctx context.Context, cfg *config.Con
 		}, nil
 	}
 
-	itemData, _ := u.inventoryRepository.FindItemInIds(pctx, cfg.Grpc.ItemUrl, &itemPb.FindItemInIdsReq{
-		Ids: func() []string {
-			itemIds := make([]string, 0)
-			for _, v := range inventoryData {
-				itemIds = append(itemIds, v.ItemId)
-			}
-			return itemIds
-		}(),
-	})
-
-	itemMaps := make(map[string]*item.ItemShowCase)
-	for _, v := range itemData.Items {
-		itemMaps[v.Id] = &item.ItemShowCase{
-			ItemId:   v.Id,
-			Title:    v.Title,
-			Price:    v.Price,
-			ImageUrl: v.ImageUrl,
-			Damage:   int(v.Damage),
-		}
-	}
+	// A listing never fails on the catalog, missing items fall back to their snapshot
+	itemMaps, reachable := u.findLiveItems(pctx, cfg, inventoryData)
 
 	results := make([]*inventory.ItemInInventory, 0)
 	for _, v := range inventoryData {
+		live := itemMaps[v.ItemId]
 		results = append(results, &inventory.ItemInInventory{
-			InventoryId: v.Id.Hex(),
-			PlayerId:    v.PlayerId,
-			ItemShowCase: &item.ItemShowCase{
-				ItemId:   v.ItemId,
-				Title:    itemMaps[v.ItemId].Title,
-				Price:    itemMaps[v.ItemId].Price,
-				Damage:   itemMaps[v.ItemId].Damage,
-				ImageUrl: itemMaps[v.ItemId].ImageUrl,
-			},
+			InventoryId:  v.Id.Hex(),
+			PlayerId:     v.PlayerId,
+			Quantity:     v.Quantity,
+			EquippedSlot: v.EquippedSlot,
+			ExpiresAt:    v.ExpiresAt,
+			UpgradeLevel: v.UpgradeLevel,
+			Retired:      reachable && live == nil,
+			ItemShowCase: itemShowCaseOf(v, live),
 		})
 	}
 
-	// Count
-	total, err := u.inventoryRepository.CountPlayerItems(pctx, playerId)
-	if err != nil {
-		return nil, err
-	}
+	first := query.Encode()
+	query.Set("start", results[len(results)-1].InventoryId)
 
 	return &models.PaginateRes{
 		Data:  results,
 		Total: total,
 		Limit: req.Limit,
 		First: models.FirstPaginate{
-			Href: fmt.Sprintf("%s/%s?limit=%d", cfg.Paginate.InventoryNextPageBasedUrl, playerId, req.Limit),
+			Href: fmt.Sprintf("%s/%s?%s", cfg.Paginate.InventoryNextPageBasedUrl, playerId, first),
 		},
 		Next: models.NextPaginate{
 			Start: results[len(results)-1].InventoryId,
-			Href:  fmt.Sprintf("%s/%s?limit=%d&start=%s", cfg.Paginate.InventoryNextPageBasedUrl, playerId, req.Limit, results[len(results)-1].InventoryId),
+			Href:  fmt.Sprintf("%s/%s?%s", cfg.Paginate.InventoryNextPageBasedUrl, playerId, query.Encode()),
 		},
+		Degraded: !reachable,
 	}, nil
 }
 
+// findItem returns nil without error when the item is unknown or disabled
+func (u *inventoryUsecase) findItem(pctx context.Context, cfg *config.Config, itemId string) (*itemPb.Item, error) {
+	itemData, err := u.inventoryRepository.FindItemInIds(pctx, cfg.Grpc.ItemUrl, &itemPb.FindItemInIdsReq{
+		Ids: []string{itemId},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range itemData.Items {
+		if strings.TrimPrefix(v.Id, "item:") == strings.TrimPrefix(itemId, "item:") {
+			return v, nil
+		}
+	}
+	return nil, nil
+}
+
+// maxStackOf tells how far the item stacks, 0 means every unit is its own entry
+func maxStackOf(itemData *itemPb.Item) int {
+	if itemData == nil || !itemData.Stackable {
+		return 0
+	}
+	return int(itemData.MaxStack)
+}
+
+// grantItem adds one unit of the item, an expansion item raises the capacity instead of taking a slot
+// and an item with a duration starts its own entry that expires once the duration is over.
+// The recorded event takes its source and reason from origin
+func (u *inventoryUsecase) grantItem(pctx context.Context, cfg *config.Config, playerId, itemId string, origin *inventory.InventoryEvent) (string, error) {
+	itemData, err := u.findItem(pctx, cfg, itemId)
+	if err != nil {
+		return "", err
+	}
+	if itemData == nil {
+		return "", errors.New("error: item not found")
+	}
+
+	if itemData.InventorySlots > 0 {
+		expansionId, err := u.inventoryRepository.InsertOneExpansion(pctx, &inventory.InventoryExpansion{
+			PlayerId:  playerId,
+			ItemId:    itemId,
+			Slots:     int64(itemData.InventorySlots),
+			CreatedAt: utils.LocalTime(),
+		})
+		if err != nil {
+			return "", err
+		}
+		if err := u.inventoryRepository.IncreaseCapacity(pctx, playerId, int64(itemData.InventorySlots), cfg.Inventory.BaseCapacity); err != nil {
+			u.inventoryRepository.DeleteOneExpansion(pctx, expansionId.Hex())
+			return "", err
+		}
+
+		// Expansions raise the capacity without holding anything, so they carry no quantity
+		u.recordEvent(pctx, &inventory.InventoryEvent{
+			Type:        "inventory.expanded",
+			PlayerId:    playerId,
+			InventoryId: expansionId.Hex(),
+			ItemId:      itemId,
+			Source:      origin.Source,
+			SourceId:    origin.SourceId,
+			Reason:      origin.Reason,
+		})
+		return expansionId.Hex(), nil
+	}
+
+	capacity, err := u.inventoryRepository.FindOrInsertCapacity(pctx, playerId, cfg.Inventory.BaseCapacity)
+	if err != nil {
+		return "", err
+	}
+
+	req := &inventory.Inventory{
+		PlayerId: playerId,
+		ItemId:   itemId,
+	}
+	if itemData != nil {
+		req.Item = snapshotFromPb(itemData)
+	}
+	if itemData != nil && itemData.DurationSeconds > 0 {
+		expiresAt := utils.LocalTime().Add(time.Duration(itemData.DurationSeconds) * time.Second)
+		req.ExpiresAt = &expiresAt
+	}
+
+	inventoryId, err := u.inventoryRepository.AddOnePlayerItem(pctx, req, maxStackOf(itemData), capacity)
+	if err != nil {
+		return "", err
+	}
+
+	u.recordEvent(pctx, &inventory.InventoryEvent{
+		Type:        "inventory.added",
+		PlayerId:    playerId,
+		InventoryId: inventoryId,
+		ItemId:      itemId,
+		Quantity:    1,
+		Source:      origin.Source,
+		SourceId:    origin.SourceId,
+		Reason:      origin.Reason,
+	})
+	return inventoryId, nil
+}
+
 func (u *inventoryUsecase) AddPlayerItemRes(pctx context.Context, cfg *config.Config, req *inventory.UpdateInventoryReq) {
-	inventoryId, err := u.inventoryRepository.InsertOnePlayerItem(pctx, &inventory.Inventory{
-		PlayerId: req.PlayerId,
-		ItemId:   req.ItemId,
+	inventoryId, err := u.grantItem(pctx, cfg, req.PlayerId, req.ItemId, &inventory.InventoryEvent{
+		Source:   "saga",
+		SourceId: req.SagaId,
 	})
 	if err != nil {
 		u.inventoryRepository.AddPlayerItemRes(pctx, cfg, &payment.PaymentTransferRes{
@@ -153,7 +331,7 @@ func (u *inventoryUsecase) AddPlayerItemRes(pctx context.Context, cfg *config.Co
 	}
 
 	u.inventoryRepository.AddPlayerItemRes(pctx, cfg, &payment.PaymentTransferRes{
-		InventoryId:   inventoryId.Hex(),
+		InventoryId:   inventoryId,
 		TransactionId: "",
 		PlayerId:      req.PlayerId,
 		ItemId:        req.ItemId,
@@ -164,18 +342,29 @@ func (u *inventoryUsecase) AddPlayerItemRes(pctx context.Context, cfg *config.Co
 
 func (u *inventoryUsecase) RemovePlayerItemRes(pctx context.Context, cfg *config.Config, req *inventory.UpdateInventoryReq) {
 	if !u.inventoryRepository.FindOnePlayerItem(pctx, req.PlayerId, req.ItemId) {
+		errMsg := "error: item not found"
+		equipped, _ := u.inventoryRepository.FindPlayerItems(pctx, bson.D{
+			{"player_id", req.PlayerId},
+			{"item_id", req.ItemId},
+			{"equipped_slot", bson.D{{"$exists", true}}},
+		}, nil)
+		if len(equipped) > 0 {
+			errMsg = "error: item is equipped"
+		}
+
 		u.inventoryRepository.RemovePlayerItemRes(pctx, cfg, &payment.PaymentTransferRes{
 			InventoryId:   "",
 			TransactionId: "",
 			PlayerId:      req.PlayerId,
 			ItemId:        req.ItemId,
 			Amount:        0,
-			Error:         "error: item not found",
+			Error:         errMsg,
 		})
 		return
 	}
 
-	if err := u.inventoryRepository.DeleteOnePlayerItem(pctx, req.PlayerId, req.ItemId); err != nil {
+	entry, err := u.inventoryRepository.DeleteOnePlayerItem(pctx, req.PlayerId, req.ItemId)
+	if err != nil {
 		u.inventoryRepository.RemovePlayerItemRes(pctx, cfg, &payment.PaymentTransferRes{
 			InventoryId:   "",
 			TransactionId: "",
@@ -187,6 +376,17 @@ func (u *inventoryUsecase) RemovePlayerItemRes(pctx context.Context, cfg *config
 		return
 	}
 
+	u.recordEvent(pctx, &inventory.InventoryEvent{
+		Type:        "inventory.removed",
+		PlayerId:    req.PlayerId,
+		InventoryId: entry.Id.Hex(),
+		ItemId:      req.ItemId,
+		Quantity:    -1,
+		Source:      "saga",
+		SourceId:    req.SagaId,
+		Entry:       removedEntry(entry),
+	})
+
 	u.inventoryRepository.RemovePlayerItemRes(pctx, cfg, &payment.PaymentTransferRes{
 		InventoryId:   "",
 		TransactionId: "",
@@ -198,12 +398,731 @@ func (u *inventoryUsecase) RemovePlayerItemRes(pctx context.Context, cfg *config
 }
 
 func (u *inventoryUsecase) RollbackAddPlayerItem(pctx context.Context, cfg *config.Config, req *inventory.RollbackPlayerInventoryReq) {
-	u.inventoryRepository.DeleteOneInventory(pctx, req.InventoryId)
+	if err := u.revertGrant(pctx, cfg, req.InventoryId, &inventory.InventoryEvent{
+		Source:   "saga",
+		SourceId: req.SagaId,
+	}); err != nil {
+		log.Printf("Error: RollbackAddPlayerItem failed: saga %s entry %s: %s", req.SagaId, req.InventoryId, err.Error())
+	}
 }
 
 func (u *inventoryUsecase) RollbackRemovePlayerItem(pctx context.Context, cfg *config.Config, req *inventory.RollbackPlayerInventoryReq) {
-	u.inventoryRepository.InsertOnePlayerItem(pctx, &inventory.Inventory{
-		PlayerId: req.PlayerId,
-		ItemId:   req.ItemId,
+	if err := u.restoreItem(pctx, cfg, req.PlayerId, req.ItemId, &inventory.InventoryEvent{
+		Source:   "saga",
+		SourceId: req.SagaId,
+	}); err != nil {
+		log.Printf("Error: RollbackRemovePlayerItem failed: saga %s item %s: %s", req.SagaId, req.ItemId, err.Error())
+	}
+}
+
+// revertGrant undoes one unit handed out by grantItem, an expansion gives its slots back.
+// An entry that is already gone counts as reverted
+func (u *inventoryUsecase) revertGrant(pctx context.Context, cfg *config.Config, inventoryId string, origin *inventory.InventoryEvent) error {
+	expansion, err := u.inventoryRepository.DeleteOneExpansion(pctx, inventoryId)
+	if err != nil {
+		return err
+	}
+	if expansion != nil {
+		if err := u.inventoryRepository.IncreaseCapacity(pctx, expansion.PlayerId, -expansion.Slots, cfg.Inventory.BaseCapacity); err != nil {
+			log.Printf("Error: revertGrant failed: %d slots of expansion %s not taken back from %s: %s", expansion.Slots, inventoryId, expansion.PlayerId, err.Error())
+		}
+		u.recordEvent(pctx, &inventory.InventoryEvent{
+			Type:        "inventory.rolled_back",
+			PlayerId:    expansion.PlayerId,
+			InventoryId: inventoryId,
+			ItemId:      expansion.ItemId,
+			Source:      origin.Source,
+			SourceId:    origin.SourceId,
+		})
+		return nil
+	}
+
+	entry, err := u.inventoryRepository.DeleteOneInventory(pctx, inventoryId)
+	if err != nil {
+		return err
+	}
+	if entry == nil {
+		return nil
+	}
+
+	u.recordEvent(pctx, &inventory.InventoryEvent{
+		Type:        "inventory.rolled_back",
+		PlayerId:    entry.PlayerId,
+		InventoryId: inventoryId,
+		ItemId:      entry.ItemId,
+		Quantity:    -1,
+		Source:      origin.Source,
+		SourceId:    origin.SourceId,
+	})
+	return nil
+}
+
+// removedEntry is the entry to keep on the removal event, only a unit that took the whole entry
+// with it needs one, a unit off a stack goes back onto the stack
+func removedEntry(before *inventory.Inventory) *inventory.Inventory {
+	if before.Quantity > 1 {
+		return nil
+	}
+	return before
+}
+
+// restoreItem gives back one unit that was taken. An entry removed as a whole comes back as it was,
+// otherwise the unit has to come back even if the item lookup fails or the inventory is full,
+// it is then kept as its own entry
+func (u *inventoryUsecase) restoreItem(pctx context.Context, cfg *config.Config, playerId, itemId string, origin *inventory.InventoryEvent) error {
+	removed, err := u.inventoryRepository.FindInventoryEvents(pctx, bson.D{
+		{"player_id", playerId},
+		{"item_id", itemId},
+		{"source", origin.Source},
+		{"source_id", origin.SourceId},
+		{"entry", bson.D{{"$exists", true}}},
+	}, nil)
+	if err != nil {
+		log.Printf("Error: restoreItem failed: %s", err.Error())
+	}
+	for _, v := range removed {
+		restored, err := u.inventoryRepository.RestoreOneInventory(pctx, v.Entry)
+		if err != nil {
+			return err
+		}
+		if !restored {
+			continue
+		}
+
+		u.recordEvent(pctx, &inventory.InventoryEvent{
+			Type:        "inventory.rolled_back",
+			PlayerId:    playerId,
+			InventoryId: v.Entry.Id.Hex(),
+			ItemId:      itemId,
+			Quantity:    v.Entry.Quantity,
+			Source:      origin.Source,
+			SourceId:    origin.SourceId,
+		})
+		return nil
+	}
+
+	itemData, err := u.findItem(pctx, cfg, itemId)
+	if err != nil {
+		log.Printf("Error: restoreItem failed: %s", err.Error())
+	}
+	inventoryId, err := u.inventoryRepository.AddOnePlayerItem(pctx, &inventory.Inventory{
+		PlayerId: playerId,
+		ItemId:   itemId,
+	}, maxStackOf(itemData), 0)
+	if err != nil {
+		return err
+	}
+
+	u.recordEvent(pctx, &inventory.InventoryEvent{
+		Type:        "inventory.rolled_back",
+		PlayerId:    playerId,
+		InventoryId: inventoryId,
+		ItemId:      itemId,
+		Quantity:    1,
+		Source:      origin.Source,
+		SourceId:    origin.SourceId,
+	})
+	return nil
+}
+
+func (u *inventoryUsecase) FindPlayerCapacity(pctx context.Context, cfg *config.Config, playerId string) (*inventory.InventoryCapacityRes, error) {
+	capacity, err := u.inventoryRepository.FindOrInsertCapacity(pctx, playerId, cfg.Inventory.BaseCapacity)
+	if err != nil {
+		return nil, err
+	}
+
+	used, err := u.inventoryRepository.CountPlayerItems(pctx, playerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &inventory.InventoryCapacityRes{
+		PlayerId: playerId,
+		Capacity: capacity,
+		Used:     used,
+		Free:     max(capacity-used, 0),
+	}, nil
+}
+
+func tradeToShowCase(trade *inventory.Trade) *inventory.TradeShowCase {
+	loc, _ := time.LoadLocation("Asia/Bangkok")
+
+	return &inventory.TradeShowCase{
+		TradeId:      trade.Id.Hex(),
+		Initiator:    &trade.Initiator,
+		Counterparty: &trade.Counterparty,
+		Status:       trade.Status,
+		ExpiresAt:    trade.ExpiresAt.In(loc),
+		CreatedAt:    trade.CreatedAt.In(loc),
+		UpdatedAt:    trade.UpdatedAt.In(loc),
+	}
+}
+
+func (u *inventoryUsecase) findPendingTrade(pctx context.Context, playerId, tradeId string) (*inventory.Trade, error) {
+	trade, err := u.inventoryRepository.FindOneTrade(pctx, tradeId)
+	if err != nil {
+		return nil, err
+	}
+
+	if trade.Initiator.PlayerId != playerId && trade.Counterparty.PlayerId != playerId {
+		log.Printf("Error: player %s is not part of trade %s", playerId, tradeId)
+		return nil, errors.New("error: trade not found")
+	}
+
+	if trade.Status != "pending" {
+		return nil, errors.New("error: trade is no longer pending")
+	}
+
+	if trade.ExpiresAt.Before(utils.LocalTime()) {
+		u.expireTrade(pctx, trade)
+		return nil, errors.New("error: trade is expired")
+	}
+
+	return trade, nil
+}
+
+func (u *inventoryUsecase) CreateTrade(pctx context.Context, cfg *config.Config, playerId string, req *inventory.CreateTradeReq) (*inventory.TradeShowCase, error) {
+	counterpartyId := "player:" + strings.TrimPrefix(req.CounterpartyId, "player:")
+	if counterpartyId == playerId {
+		return nil, errors.New("error: cannot trade with yourself")
+	}
+
+	profile, err := u.inventoryRepository.FindOnePlayerProfile(pctx, cfg.Grpc.PlayerUrl, &playerPb.FindOnePlayerProfileToRefreshReq{
+		PlayerId: strings.TrimPrefix(counterpartyId, "player:"),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if profile.Id == primitive.NilObjectID.Hex() {
+		return nil, errors.New("error: counterparty not found")
+	}
+
+	tradeId, err := u.inventoryRepository.InsertOneTrade(pctx, &inventory.Trade{
+		Initiator: inventory.TradeOffer{
+			PlayerId: playerId,
+			Items:    make([]*inventory.TradeOfferItem, 0),
+		},
+		Counterparty: inventory.TradeOffer{
+			PlayerId: counterpartyId,
+			Items:    make([]*inventory.TradeOfferItem, 0),
+		},
+		Status:    "pending",
+		ExpiresAt: utils.LocalTime().Add(15 * time.Minute),
+		CreatedAt: utils.LocalTime(),
+		UpdatedAt: utils.LocalTime(),
 	})
+	if err != nil {
+		return nil, err
+	}
+
+	return u.FindOneTrade(pctx, playerId, tradeId.Hex())
+}
+
+func (u *inventoryUsecase) FindOneTrade(pctx context.Context, playerId, tradeId string) (*inventory.TradeShowCase, error) {
+	trade, err := u.inventoryRepository.FindOneTrade(pctx, tradeId)
+	if err != nil {
+		return nil, err
+	}
+
+	if trade.Initiator.PlayerId != playerId && trade.Counterparty.PlayerId != playerId {
+		log.Printf("Error: player %s is not part of trade %s", playerId, tradeId)
+		return nil, errors.New("error: trade not found")
+	}
+
+	return tradeToShowCase(trade), nil
+}
+
+func (u *inventoryUsecase) UpdateTradeOffer(pctx context.Context, cfg *config.Config, playerId, tradeId string, req *inventory.UpdateTradeOfferReq) (*inventory.TradeShowCase, error) {
+	trade, err := u.findPendingTrade(pctx, playerId, tradeId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Release whatever this side offered before, then lock the new offer
+	if err := u.inventoryRepository.UnlockPlayerItems(pctx, playerId, tradeId); err != nil {
+		return nil, err
+	}
+
+	capacity, err := u.inventoryRepository.FindOrInsertCapacity(pctx, playerId, cfg.Inventory.BaseCapacity)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*inventory.TradeOfferItem, 0)
+	for _, itemId := range req.ItemIds {
+		if !u.inventoryRepository.FindOnePlayerItem(pctx, playerId, itemId) {
+			u.inventoryRepository.UnlockPlayerItems(pctx, playerId, tradeId)
+			return nil, errors.New("error: item not found")
+		}
+
+		inventoryId, err := u.inventoryRepository.LockOnePlayerItem(pctx, playerId, itemId, tradeId, capacity)
+		if err != nil {
+			u.inventoryRepository.UnlockPlayerItems(pctx, playerId, tradeId)
+			return nil, err
+		}
+
+		items = append(items, &inventory.TradeOfferItem{
+			InventoryId: inventoryId,
+			ItemId:      itemId,
+		})
+	}
+
+	side := "counterparty"
+	if trade.Initiator.PlayerId == playerId {
+		side = "initiator"
+	}
+
+	// Any change to an offer voids both confirmations
+	if err := u.inventoryRepository.UpdateOneTrade(pctx, tradeId, bson.M{
+		side + ".items":          items,
+		side + ".coins":          req.Coins,
+		"initiator.confirmed":    false,
+		"counterparty.confirmed": false,
+		"updated_at":             utils.LocalTime(),
+	}); err != nil {
+		u.inventoryRepository.UnlockPlayerItems(pctx, playerId, tradeId)
+		return nil, err
+	}
+
+	return u.FindOneTrade(pctx, playerId, tradeId)
+}
+
+func (u *inventoryUsecase) ConfirmTrade(pctx context.Context, cfg *config.Config, playerId, tradeId string) (*inventory.TradeShowCase, error) {
+	trade, err := u.findPendingTrade(pctx, playerId, tradeId)
+	if err != nil {
+		return nil, err
+	}
+
+	side := "counterparty"
+	if trade.Initiator.PlayerId == playerId {
+		side = "initiator"
+	}
+
+	if err := u.inventoryRepository.UpdateOneTrade(pctx, tradeId, bson.M{
+		side + ".confirmed": true,
+		"updated_at":        utils.LocalTime(),
+	}); err != nil {
+		return nil, err
+	}
+
+	// Only the confirmation that finds both sides confirmed moves the trade forward, and it works
+	// from the trade as it was at that moment so a late offer change can't slip in
+	trade, err = u.inventoryRepository.StartTrade(pctx, tradeId)
+	if err != nil {
+		return nil, err
+	}
+	if trade == nil {
+		return u.FindOneTrade(pctx, playerId, tradeId)
+	}
+
+	if err := u.executeTrade(pctx, cfg, trade); err != nil {
+		u.revertTrade(pctx, cfg, trade)
+		return nil, err
+	}
+
+	u.settleTrade(pctx, trade)
+
+	return u.FindOneTrade(pctx, playerId, tradeId)
+}
+
+type tradeLeg struct{ from, to *inventory.TradeOffer }
+
+func tradeLegs(trade *inventory.Trade) []tradeLeg {
+	return []tradeLeg{
+		{&trade.Initiator, &trade.Counterparty},
+		{&trade.Counterparty, &trade.Initiator},
+	}
+}
+
+// executeTrade moves the coins and the locked entries, undoing a failure is left to revertTrade
+func (u *inventoryUsecase) executeTrade(pctx context.Context, cfg *config.Config, trade *inventory.Trade) error {
+	tradeId := trade.Id.Hex()
+
+	// Each side ends up with the other side's entries in place of its own, so it needs the difference free
+	for _, leg := range tradeLegs(trade) {
+		incoming := int64(len(leg.from.Items) - len(leg.to.Items))
+		if incoming <= 0 {
+			continue
+		}
+
+		capacity, err := u.inventoryRepository.FindOrInsertCapacity(pctx, leg.to.PlayerId, cfg.Inventory.BaseCapacity)
+		if err != nil {
+			return err
+		}
+		used, err := u.inventoryRepository.CountPlayerItems(pctx, leg.to.PlayerId)
+		if err != nil {
+			return err
+		}
+		if used+incoming > capacity {
+			return inventory.ErrInventoryFull
+		}
+	}
+
+	// Stage 1: move coins, debiting before crediting so a short balance fails first. Every
+	// transaction is saved on the trade before the next one is made
+	addTransaction := func(playerId string, amount float64) error {
+		transaction, err := u.inventoryRepository.CreatePlayerTransaction(pctx, cfg.Grpc.PlayerUrl, &playerPb.CreatePlayerTransactionReq{
+			PlayerId: playerId,
+			Amount:   amount,
+		})
+		if err != nil {
+			return errors.New("error: trade coins transfer failed")
+		}
+		trade.TransactionIds = append(trade.TransactionIds, transaction.TransactionId)
+
+		return u.inventoryRepository.AddTradeTransaction(pctx, tradeId, transaction.TransactionId)
+	}
+	for _, leg := range tradeLegs(trade) {
+		if leg.from.Coins <= 0 {
+			continue
+		}
+		if err := addTransaction(leg.from.PlayerId, -leg.from.Coins); err != nil {
+			return err
+		}
+		if err := addTransaction(leg.to.PlayerId, leg.from.Coins); err != nil {
+			return err
+		}
+	}
+
+	// Stage 2: hand over the entries, only those still locked by this trade move
+	for _, leg := range tradeLegs(trade) {
+		for _, v := range leg.from.Items {
+			if err := u.inventoryRepository.TransferOnePlayerItem(pctx, v.InventoryId, leg.from.PlayerId, leg.to.PlayerId, tradeId); err != nil {
+				return errors.New("error: trade items transfer failed")
+			}
+		}
+	}
+
+	// Every entry has moved, from here on the trade is settled rather than undone
+	if !u.inventoryRepository.UpdateTradeStatus(pctx, tradeId, "processing", "settling") {
+		return errors.New("error: trade is no longer processing")
+	}
+
+	return nil
+}
+
+// revertTrade puts a trade that did not go through back the way it was. Each step only matches
+// what is left to undo so it can run again on a trade whose worker stopped, a rollback that fails
+// leaves the trade processing for the next sweep to retry
+func (u *inventoryUsecase) revertTrade(pctx context.Context, cfg *config.Config, trade *inventory.Trade) {
+	tradeId := trade.Id.Hex()
+
+	// Entries that moved are still locked by the trade on their new owner
+	for _, leg := range tradeLegs(trade) {
+		for _, v := range leg.from.Items {
+			u.inventoryRepository.TransferOnePlayerItem(pctx, v.InventoryId, leg.to.PlayerId, leg.from.PlayerId, tradeId)
+		}
+	}
+
+	for _, transactionId := range trade.TransactionIds {
+		if err := u.inventoryRepository.RollbackPlayerTransaction(pctx, cfg.Grpc.PlayerUrl, &playerPb.RollbackPlayerTransactionReq{
+			TransactionId: transactionId,
+		}); err != nil {
+			log.Printf("Error: revertTrade failed: trade %s left processing: %s", tradeId, err.Error())
+			return
+		}
+	}
+
+	u.inventoryRepository.UnlockPlayerItems(pctx, trade.Initiator.PlayerId, tradeId)
+	u.inventoryRepository.UnlockPlayerItems(pctx, trade.Counterparty.PlayerId, tradeId)
+	u.inventoryRepository.UpdateTradeStatus(pctx, tradeId, "processing", "failed")
+}
+
+// settleTrade releases the entries to their new owners and records the trade in their history
+func (u *inventoryUsecase) settleTrade(pctx context.Context, trade *inventory.Trade) {
+	tradeId := trade.Id.Hex()
+
+	if err := u.inventoryRepository.UnlockPlayerItems(pctx, trade.Initiator.PlayerId, tradeId); err != nil {
+		return
+	}
+	if err := u.inventoryRepository.UnlockPlayerItems(pctx, trade.Counterparty.PlayerId, tradeId); err != nil {
+		return
+	}
+
+	// A settle run again after a crash does not record the trade twice
+	recorded, err := u.inventoryRepository.CountInventoryEvents(pctx, bson.D{{"source", "trade"}, {"source_id", tradeId}})
+	if err != nil {
+		return
+	}
+	if recorded == 0 {
+		for _, leg := range tradeLegs(trade) {
+			for _, v := range leg.from.Items {
+				u.recordEvent(pctx, &inventory.InventoryEvent{
+					Type:        "inventory.traded_out",
+					PlayerId:    leg.from.PlayerId,
+					InventoryId: v.InventoryId,
+					ItemId:      v.ItemId,
+					Quantity:    -1,
+					Source:      "trade",
+					SourceId:    tradeId,
+				})
+				u.recordEvent(pctx, &inventory.InventoryEvent{
+					Type:        "inventory.traded_in",
+					PlayerId:    leg.to.PlayerId,
+					InventoryId: v.InventoryId,
+					ItemId:      v.ItemId,
+					Quantity:    1,
+					Source:      "trade",
+					SourceId:    tradeId,
+				})
+			}
+		}
+	}
+
+	u.inventoryRepository.UpdateTradeStatus(pctx, tradeId, "settling", "completed")
+}
+
+func (u *inventoryUsecase) CancelTrade(pctx context.Context, playerId, tradeId string) (*inventory.TradeShowCase, error) {
+	trade, err := u.findPendingTrade(pctx, playerId, tradeId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !u.inventoryRepository.UpdateTradeStatus(pctx, tradeId, "pending", "cancelled") {
+		return nil, errors.New("error: trade is no longer pending")
+	}
+
+	u.inventoryRepository.UnlockPlayerItems(pctx, trade.Initiator.PlayerId, tradeId)
+	u.inventoryRepository.UnlockPlayerItems(pctx, trade.Counterparty.PlayerId, tradeId)
+
+	return u.FindOneTrade(pctx, playerId, tradeId)
+}
+
+func (u *inventoryUsecase) expireTrade(pctx context.Context, trade *inventory.Trade) {
+	if !u.inventoryRepository.UpdateTradeStatus(pctx, trade.Id.Hex(), "pending", "expired") {
+		return
+	}
+
+	u.inventoryRepository.UnlockPlayerItems(pctx, trade.Initiator.PlayerId, trade.Id.Hex())
+	u.inventoryRepository.UnlockPlayerItems(pctx, trade.Counterparty.PlayerId, trade.Id.Hex())
+}
+
+// ExpireTrades closes the pending trades that ran out of time and finishes the ones whose worker
+// stopped halfway, a trade still processing is undone and one already settling is settled
+func (u *inventoryUsecase) ExpireTrades(pctx context.Context, cfg *config.Config) {
+	trades, err := u.inventoryRepository.FindExpiredTrades(pctx)
+	if err != nil {
+		return
+	}
+
+	for _, trade := range trades {
+		u.expireTrade(pctx, trade)
+	}
+
+	stuck, err := u.inventoryRepository.FindStuckTrades(pctx)
+	if err != nil {
+		return
+	}
+
+	for _, trade := range stuck {
+		log.Printf("Info: ExpireTrades recovering trade %s from %s", trade.Id.Hex(), trade.Status)
+		switch trade.Status {
+		case "processing":
+			u.revertTrade(pctx, cfg, trade)
+		case "settling":
+			u.settleTrade(pctx, trade)
+		}
+	}
+}
+
+// ExpireItems purges expired entries and publishes an event for each one it removed
+func (u *inventoryUsecase) ExpireItems(pctx context.Context, cfg *config.Config) {
+	expired, err := u.inventoryRepository.FindExpiredInventories(pctx)
+	if err != nil {
+		return
+	}
+
+	// The event goes out before the entry is removed, an entry whose event could not be published
+	// is kept for the next sweep rather than disappearing unannounced
+	for _, v := range expired {
+		event := &inventory.InventoryEvent{
+			EventId:      primitive.NewObjectID().Hex(),
+			Type:         "inventory.expired",
+			PlayerId:     v.PlayerId,
+			InventoryId:  v.Id.Hex(),
+			ItemId:       v.ItemId,
+			Quantity:     -v.Quantity,
+			EquippedSlot: v.EquippedSlot,
+			Source:       "sweeper",
+			OccurredAt:   utils.LocalTime(),
+		}
+		if err := u.inventoryRepository.PublishInventoryEvent(pctx, cfg, event); err != nil {
+			log.Printf("Error: ExpireItems failed: publish %s: %s", v.Id.Hex(), err.Error())
+			continue
+		}
+
+		if !u.inventoryRepository.DeleteExpiredInventory(pctx, v.Id.Hex()) {
+			continue
+		}
+
+		u.recordEvent(pctx, event)
+	}
+}
+
+// HasItems only counts items that are not locked by a trade, a repeated item id needs that many copies
+func (u *inventoryUsecase) HasItems(pctx context.Context, req *inventoryPb.HasItemsReq) (*inventoryPb.HasItemsRes, error) {
+	if req.PlayerId == "" {
+		return nil, errors.New("error: player id is required")
+	}
+
+	required := make(map[string]int64)
+	itemIds := make([]string, 0)
+	for _, itemId := range req.ItemIds {
+		if required[itemId] == 0 {
+			itemIds = append(itemIds, itemId)
+		}
+		required[itemId]++
+	}
+
+	counts, err := u.inventoryRepository.CountPlayerItemsInIds(pctx, req.PlayerId, itemIds, true, false)
+	if err != nil {
+		return nil, err
+	}
+
+	missingItemIds := make([]string, 0)
+	for _, itemId := range itemIds {
+		if counts[itemId] < required[itemId] {
+			missingItemIds = append(missingItemIds, itemId)
+		}
+	}
+
+	return &inventoryPb.HasItemsRes{
+		HasAll:         len(missingItemIds) == 0,
+		MissingItemIds: missingItemIds,
+	}, nil
+}
+
+func (u *inventoryUsecase) CountPlayerItems(pctx context.Context, req *inventoryPb.CountPlayerItemsReq) (*inventoryPb.CountPlayerItemsRes, error) {
+	if req.PlayerId == "" {
+		return nil, errors.New("error: player id is required")
+	}
+
+	if req.ItemId == "" {
+		counts, err := u.inventoryRepository.CountPlayerItemsInIds(pctx, req.PlayerId, nil, false, false)
+		if err != nil {
+			return nil, err
+		}
+		count := int64(0)
+		for _, v := range counts {
+			count += v
+		}
+		return &inventoryPb.CountPlayerItemsRes{Count: count}, nil
+	}
+
+	counts, err := u.inventoryRepository.CountPlayerItemsInIds(pctx, req.PlayerId, []string{req.ItemId}, false, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return &inventoryPb.CountPlayerItemsRes{Count: counts[req.ItemId]}, nil
+}
+
+func (u *inventoryUsecase) ListPlayerItems(pctx context.Context, req *inventoryPb.ListPlayerItemsReq) (*inventoryPb.ListPlayerItemsRes, error) {
+	if req.PlayerId == "" {
+		return nil, errors.New("error: player id is required")
+	}
+
+	limit := int64(req.Limit)
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	filter := bson.D{}
+	if req.Start != "" {
+		filter = append(filter, bson.E{"_id", bson.D{{"$gt", utils.ConvertToObjectId(req.Start)}}})
+	}
+	filter = append(filter, bson.E{"player_id", req.PlayerId})
+
+	opts := make([]*options.FindOptions, 0)
+	opts = append(opts, options.Find().SetSort(bson.D{{"_id", 1}}))
+	opts = append(opts, options.Find().SetLimit(limit))
+
+	inventoryData, err := u.inventoryRepository.FindPlayerItems(pctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	total, err := u.inventoryRepository.CountPlayerItems(pctx, req.PlayerId)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*inventoryPb.InventoryEntry, 0)
+	for _, v := range inventoryData {
+		entries = append(entries, toInventoryEntry(v))
+	}
+
+	nextStart := ""
+	if int64(len(inventoryData)) == limit {
+		nextStart = inventoryData[len(inventoryData)-1].Id.Hex()
+	}
+
+	return &inventoryPb.ListPlayerItemsRes{
+		Entries:   entries,
+		Total:     total,
+		NextStart: nextStart,
+	}, nil
+}
+
+func (u *inventoryUsecase) GetInventoryEntry(pctx context.Context, req *inventoryPb.GetInventoryEntryReq) (*inventoryPb.InventoryEntry, error) {
+	result, err := u.inventoryRepository.FindOneInventory(pctx, req.InventoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	return toInventoryEntry(result), nil
+}
+
+// GetInventoryEntries looks up the player's entries in one go, in the order asked for. Entries that
+// are gone, expired or owned by someone else are left out
+func (u *inventoryUsecase) GetInventoryEntries(pctx context.Context, req *inventoryPb.GetInventoryEntriesReq) (*inventoryPb.GetInventoryEntriesRes, error) {
+	res := &inventoryPb.GetInventoryEntriesRes{Entries: make([]*inventoryPb.InventoryEntry, 0)}
+	if len(req.InventoryIds) == 0 {
+		return res, nil
+	}
+
+	objectIds := make([]primitive.ObjectID, 0)
+	for _, v := range req.InventoryIds {
+		objectIds = append(objectIds, utils.ConvertToObjectId(v))
+	}
+
+	inventoryData, err := u.inventoryRepository.FindPlayerItems(pctx, bson.D{
+		{"_id", bson.D{{"$in", objectIds}}},
+		{"player_id", req.PlayerId},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	inventoryMaps := make(map[string]*inventory.Inventory)
+	for _, v := range inventoryData {
+		inventoryMaps[v.Id.Hex()] = v
+	}
+	for _, v := range req.InventoryIds {
+		if entry, ok := inventoryMaps[v]; ok {
+			res.Entries = append(res.Entries, toInventoryEntry(entry))
+		}
+	}
+
+	return res, nil
+}
+
+func toInventoryEntry(v *inventory.Inventory) *inventoryPb.InventoryEntry {
+	return &inventoryPb.InventoryEntry{
+		Id:           v.Id.Hex(),
+		PlayerId:     v.PlayerId,
+		ItemId:       v.ItemId,
+		LockedBy:     v.LockedBy,
+		Quantity:     v.Quantity,
+		EquippedSlot: v.EquippedSlot,
+		ExpiresAt: func() string {
+			if v.ExpiresAt == nil {
+				return ""
+			}
+			return v.ExpiresAt.Format(time.RFC3339)
+		}(),
+	}
 }
@@ -0,0 +1,205 @@
+package item
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+var catalogHeader = []string{"title", "description", "price", "damage", "image_url", "category", "rarity", "tags", "attributes", "bundle_items", "stackable", "max_stack", "inventory_slots", "equip_type", "duration_seconds", "usage_status"}
+
+// DecodeCatalog reads catalog rows from csv or json, a row that can't be parsed keeps its error in ParseError
+func DecodeCatalog(format string, r io.Reader) ([]*CatalogRow, error) {
+	switch format {
+	case "json":
+		rows := make([]*CatalogRow, 0)
+		if err := json.NewDecoder(r).Decode(&rows); err != nil {
+			return nil, fmt.Errorf("error: invalid json catalog: %s", err.Error())
+		}
+		for i, row := range rows {
+			row.Line = i + 1
+		}
+		return rows, nil
+	case "csv":
+		return decodeCatalogCsv(r)
+	}
+	return nil, errors.New("error: format must be csv or json")
+}
+
+func decodeCatalogCsv(r io.Reader) ([]*CatalogRow, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errors.New("error: csv header is required")
+	}
+	columns := make(map[string]int)
+	for i, h := range header {
+		columns[strings.TrimSpace(strings.ToLower(h))] = i
+	}
+	if _, ok := columns["title"]; !ok {
+		return nil, errors.New("error: csv title column is required")
+	}
+
+	rows := make([]*CatalogRow, 0)
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+
+		row := &CatalogRow{Line: line}
+		rows = append(rows, row)
+		if err != nil {
+			row.ParseError = err.Error()
+			continue
+		}
+
+		get := func(name string) string {
+			if i, ok := columns[name]; ok && i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		row.Title = get("title")
+		row.Description = get("description")
+		row.ImageUrl = get("image_url")
+		row.Category = get("category")
+		row.Rarity = get("rarity")
+		row.EquipType = get("equip_type")
+		row.Tags = splitList(get("tags"))
+		row.BundleItems = splitList(get("bundle_items"))
+
+		if v := get("price"); v != "" {
+			if row.Price, err = strconv.ParseFloat(v, 64); err != nil {
+				row.ParseError = "invalid price: " + v
+				continue
+			}
+		}
+		if v := get("damage"); v != "" {
+			if row.Damage, err = strconv.Atoi(v); err != nil {
+				row.ParseError = "invalid damage: " + v
+				continue
+			}
+		}
+		if v := get("attributes"); v != "" {
+			if err := json.Unmarshal([]byte(v), &row.Attributes); err != nil {
+				row.ParseError = "invalid attributes: " + err.Error()
+				continue
+			}
+		}
+		if v := get("stackable"); v != "" {
+			if row.Stackable, err = strconv.ParseBool(v); err != nil {
+				row.ParseError = "invalid stackable: " + v
+				continue
+			}
+		}
+		if v := get("max_stack"); v != "" {
+			if row.MaxStack, err = strconv.Atoi(v); err != nil {
+				row.ParseError = "invalid max_stack: " + v
+				continue
+			}
+		}
+		if v := get("inventory_slots"); v != "" {
+			if row.InventorySlots, err = strconv.Atoi(v); err != nil {
+				row.ParseError = "invalid inventory_slots: " + v
+				continue
+			}
+		}
+		if v := get("duration_seconds"); v != "" {
+			if row.DurationSeconds, err = strconv.ParseInt(v, 10, 64); err != nil {
+				row.ParseError = "invalid duration_seconds: " + v
+				continue
+			}
+		}
+		if v := get("usage_status"); v != "" {
+			status, err := strconv.ParseBool(v)
+			if err != nil {
+				row.ParseError = "invalid usage_status: " + v
+				continue
+			}
+			row.UsageStatus = &status
+		}
+	}
+
+	return rows, nil
+}
+
+func EncodeCatalog(format string, w io.Writer, rows []*CatalogRow) error {
+	switch format {
+	case "json":
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(rows)
+	case "csv":
+		writer := csv.NewWriter(w)
+		if err := writer.Write(catalogHeader); err != nil {
+			return err
+		}
+		for _, row := range rows {
+			attributes := ""
+			if len(row.Attributes) > 0 {
+				b, _ := json.Marshal(row.Attributes)
+				attributes = string(b)
+			}
+			maxStack := ""
+			if row.MaxStack > 0 {
+				maxStack = strconv.Itoa(row.MaxStack)
+			}
+			inventorySlots := ""
+			if row.InventorySlots > 0 {
+				inventorySlots = strconv.Itoa(row.InventorySlots)
+			}
+			durationSeconds := ""
+			if row.DurationSeconds > 0 {
+				durationSeconds = strconv.FormatInt(row.DurationSeconds, 10)
+			}
+			usageStatus := ""
+			if row.UsageStatus != nil {
+				usageStatus = strconv.FormatBool(*row.UsageStatus)
+			}
+			if err := writer.Write([]string{
+				row.Title,
+				row.Description,
+				strconv.FormatFloat(row.Price, 'f', -1, 64),
+				strconv.Itoa(row.Damage),
+				row.ImageUrl,
+				row.Category,
+				row.Rarity,
+				strings.Join(row.Tags, "|"),
+				attributes,
+				strings.Join(row.BundleItems, "|"),
+				strconv.FormatBool(row.Stackable),
+				maxStack,
+				inventorySlots,
+				row.EquipType,
+				durationSeconds,
+				usageStatus,
+			}); err != nil {
+				return err
+			}
+		}
+		writer.Flush()
+		return writer.Error()
+	}
+	return errors.New("error: format must be csv or json")
+}
+
+func splitList(v string) []string {
+	if v == "" {
+		return nil
+	}
+	results := make([]string, 0)
+	for _, s := range strings.Split(v, "|") {
+		if s = strings.TrimSpace(s); s != "" {
+			results = append(results, s)
+		}
+	}
+	return results
+}
@@ -0,0 +1,125 @@
+package item
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type (
+	testDecodeCatalog struct {
+		format   string
+		input    string
+		expected []*CatalogRow
+		isErr    bool
+	}
+)
+
+func TestDecodeCatalog(t *testing.T) {
+	enabled := true
+	disabled := false
+
+	tests := []testDecodeCatalog{
+		{
+			format: "csv",
+			input: "title,description,price,damage,image_url,category,rarity,tags,attributes,bundle_items,stackable,max_stack,inventory_slots,equip_type,duration_seconds,usage_status\n" +
+				"Sword,Sharp,100.5,20,http://img,weapon,rare,a|b,\"{\"\"element\"\":\"\"fire\"\"}\",,false,,,weapon,3600,true\n",
+			expected: []*CatalogRow{
+				{
+					Line:            2,
+					Title:           "Sword",
+					Description:     "Sharp",
+					Price:           100.5,
+					Damage:          20,
+					ImageUrl:        "http://img",
+					Category:        "weapon",
+					Rarity:          "rare",
+					Tags:            []string{"a", "b"},
+					Attributes:      map[string]any{"element": "fire"},
+					EquipType:       "weapon",
+					DurationSeconds: 3600,
+					UsageStatus:     &enabled,
+				},
+			},
+		},
+		{
+			// Columns are matched by name in any order and case
+			format: "csv",
+			input:  "Price,TITLE,inventory_slots,stackable,max_stack,usage_status\n5,Bag, 10 ,true,50,false\n",
+			expected: []*CatalogRow{
+				{Line: 2, Title: "Bag", Price: 5, InventorySlots: 10, Stackable: true, MaxStack: 50, UsageStatus: &disabled},
+			},
+		},
+		{
+			// A bad value fails its own row only
+			format: "csv",
+			input:  "title,price,inventory_slots,duration_seconds\nA,1,z,\nB,1,1,w\nC,1,1,1\n",
+			expected: []*CatalogRow{
+				{Line: 2, Title: "A", Price: 1, ParseError: "invalid inventory_slots: z"},
+				{Line: 3, Title: "B", Price: 1, InventorySlots: 1, ParseError: "invalid duration_seconds: w"},
+				{Line: 4, Title: "C", Price: 1, InventorySlots: 1, DurationSeconds: 1},
+			},
+		},
+		{
+			format: "json",
+			input:  `[{"title":"A","price":1,"inventory_slots":4,"equip_type":"armor","duration_seconds":60},{"title":"B"}]`,
+			expected: []*CatalogRow{
+				{Line: 1, Title: "A", Price: 1, InventorySlots: 4, EquipType: "armor", DurationSeconds: 60},
+				{Line: 2, Title: "B"},
+			},
+		},
+		{format: "csv", input: "price\n1\n", isErr: true},
+		{format: "csv", input: "", isErr: true},
+		{format: "json", input: `{"title":`, isErr: true},
+		{format: "xml", input: "", isErr: true},
+	}
+
+	for i, test := range tests {
+		fmt.Printf("case -> %d\n", i+1)
+
+		result, err := DecodeCatalog(test.format, strings.NewReader(test.input))
+
+		if test.isErr {
+			assert.NotEmpty(t, err)
+		} else {
+			assert.Equal(t, test.expected, result)
+		}
+	}
+}
+
+// TestEncodeCatalog checks an export reads back as the same rows, the new columns included
+func TestEncodeCatalog(t *testing.T) {
+	enabled := true
+	rows := []*CatalogRow{
+		{
+			Title:           "Sword",
+			Description:     "Sharp, pointy",
+			Price:           100.5,
+			Damage:          20,
+			ImageUrl:        "http://img",
+			Tags:            []string{"a", "b"},
+			Attributes:      map[string]any{"element": "fire"},
+			EquipType:       "weapon",
+			DurationSeconds: 3600,
+			UsageStatus:     &enabled,
+		},
+		{Title: "Bag", Price: 5, ImageUrl: "http://bag", Stackable: true, MaxStack: 50, InventorySlots: 10},
+	}
+
+	for _, format := range []string{"csv", "json"} {
+		buf := new(bytes.Buffer)
+		assert.NoError(t, EncodeCatalog(format, buf, rows))
+
+		result, err := DecodeCatalog(format, buf)
+		assert.NoError(t, err)
+		for _, v := range result {
+			v.Line = 0
+		}
+		assert.Equal(t, rows, result, format)
+	}
+
+	assert.NotEmpty(t, EncodeCatalog("xml", new(bytes.Buffer), rows))
+}
@@ -8,13 +8,124 @@ import (
 
 type (
 	Item struct {
-		Id          primitive.ObjectID `json:"_id" bson:"_id,omitempty"`
-		Title       string             `json:"title" bson:"title"`
-		Price       float64            `json:"price" bson:"price"`
-		Damage      int                `json:"damage" bson:"damage"`
-		ImageUrl    string             `json:"image_url" bson:"image_url"`
-		UsageStatus bool               `json:"usage_status" bson:"usage_status"`
-		CreatedAt   time.Time          `json:"created_at" bson:"created_at"`
-		UpdatedAt   time.Time          `json:"updated_at" bson:"updated_at"`
+		Id              primitive.ObjectID `json:"_id" bson:"_id,omitempty"`
+		Title           string             `json:"title" bson:"title"`
+		TitleLower      string             `json:"-" bson:"title_lower"`
+		Description     string             `json:"description,omitempty" bson:"description,omitempty"`
+		Price           float64            `json:"price" bson:"price"`
+		Damage          int                `json:"damage" bson:"damage"`
+		ImageUrl        string             `json:"image_url" bson:"image_url"`
+		Thumbnails      map[string]string  `json:"thumbnails,omitempty" bson:"thumbnails,omitempty"`
+		Category        string             `json:"category,omitempty" bson:"category,omitempty"`
+		Rarity          string             `json:"rarity,omitempty" bson:"rarity,omitempty"`
+		Tags            []string           `json:"tags,omitempty" bson:"tags,omitempty"`
+		Attributes      map[string]any     `json:"attributes,omitempty" bson:"attributes,omitempty"`
+		Stackable       bool               `json:"stackable,omitempty" bson:"stackable,omitempty"`
+		MaxStack        int                `json:"max_stack,omitempty" bson:"max_stack,omitempty"`
+		InventorySlots  int                `json:"inventory_slots,omitempty" bson:"inventory_slots,omitempty"`
+		EquipType       string             `json:"equip_type,omitempty" bson:"equip_type,omitempty"`
+		DurationSeconds int64              `json:"duration_seconds,omitempty" bson:"duration_seconds,omitempty"`
+		UsageStatus     bool               `json:"usage_status" bson:"usage_status"`
+		Version         int64              `json:"version" bson:"version"`
+		CreatedAt       time.Time          `json:"created_at" bson:"created_at"`
+		UpdatedAt       time.Time          `json:"updated_at" bson:"updated_at"`
+		LootBox         *LootBox           `json:"loot_box,omitempty" bson:"loot_box,omitempty"`
+		BundleItems     []string           `json:"bundle_items,omitempty" bson:"bundle_items,omitempty"`
+		PriceSchedules  []*PriceSchedule   `json:"price_schedules,omitempty" bson:"price_schedules,omitempty"`
+		Upgrades        []*UpgradeTier     `json:"upgrades,omitempty" bson:"upgrades,omitempty"`
+	}
+
+	// UpgradeTier i takes an owned copy of the item from upgrade level i to i+1
+	UpgradeTier struct {
+		Cost        float64 `json:"cost" bson:"cost"`
+		Damage      int     `json:"damage" bson:"damage"`
+		SuccessRate float64 `json:"success_rate" bson:"success_rate"`
+	}
+
+	Recipe struct {
+		Id             primitive.ObjectID `json:"_id" bson:"_id,omitempty"`
+		Title          string             `json:"title" bson:"title"`
+		Inputs         []*RecipeInput     `json:"inputs" bson:"inputs"`
+		Coins          float64            `json:"coins" bson:"coins"`
+		OutputItemId   string             `json:"output_item_id" bson:"output_item_id"`
+		OutputQuantity int                `json:"output_quantity" bson:"output_quantity"`
+		UsageStatus    bool               `json:"usage_status" bson:"usage_status"`
+		CreatedAt      time.Time          `json:"created_at" bson:"created_at"`
+		UpdatedAt      time.Time          `json:"updated_at" bson:"updated_at"`
+	}
+
+	RecipeInput struct {
+		ItemId   string `json:"item_id" bson:"item_id"`
+		Quantity int    `json:"quantity" bson:"quantity"`
+	}
+
+	// StorefrontRule fills featured slots every daily or weekly window, a random rule deals each
+	// player their own Slots items out of Pool and a curated rule shows the Schedule entry of the window
+	StorefrontRule struct {
+		Id          primitive.ObjectID    `json:"_id" bson:"_id,omitempty"`
+		Title       string                `json:"title" bson:"title"`
+		Period      string                `json:"period" bson:"period"`
+		Mode        string                `json:"mode" bson:"mode"`
+		Slots       int                   `json:"slots,omitempty" bson:"slots,omitempty"`
+		Pool        []string              `json:"pool,omitempty" bson:"pool,omitempty"`
+		Schedule    []*StorefrontRotation `json:"schedule,omitempty" bson:"schedule,omitempty"`
+		Seed        string                `json:"-" bson:"seed"`
+		UsageStatus bool                  `json:"usage_status" bson:"usage_status"`
+		CreatedAt   time.Time             `json:"created_at" bson:"created_at"`
+		UpdatedAt   time.Time             `json:"updated_at" bson:"updated_at"`
+	}
+
+	StorefrontRotation struct {
+		StartsAt time.Time `json:"starts_at" bson:"starts_at"`
+		ItemIds  []string  `json:"item_ids" bson:"item_ids"`
+	}
+
+	ItemEvent struct {
+		EventId    string    `json:"event_id" validate:"required"`
+		Type       string    `json:"type" validate:"required"`
+		ItemId     string    `json:"item_id" validate:"required"`
+		Version    int64     `json:"version"`
+		Item       *Item     `json:"item" validate:"required"`
+		OccurredAt time.Time `json:"occurred_at"`
+	}
+
+	ItemRevision struct {
+		Id           primitive.ObjectID `json:"_id" bson:"_id,omitempty"`
+		ItemId       string             `json:"item_id" bson:"item_id"`
+		Action       string             `json:"action" bson:"action"`
+		EditorId     string             `json:"editor_id" bson:"editor_id"`
+		Changes      []*FieldChange     `json:"changes" bson:"changes"`
+		Snapshot     *Item              `json:"snapshot" bson:"snapshot"`
+		RevertedFrom string             `json:"reverted_from,omitempty" bson:"reverted_from,omitempty"`
+		CreatedAt    time.Time          `json:"created_at" bson:"created_at"`
+	}
+
+	FieldChange struct {
+		Field string `json:"field" bson:"field"`
+		From  any    `json:"from" bson:"from"`
+		To    any    `json:"to" bson:"to"`
+	}
+
+	// PriceSchedule start_announced and end_announced are set once the price change has been published
+	PriceSchedule struct {
+		ScheduleId     string    `json:"schedule_id" bson:"schedule_id"`
+		Type           string    `json:"type" bson:"type"`
+		Value          float64   `json:"value" bson:"value"`
+		StartAt        time.Time `json:"start_at" bson:"start_at"`
+		EndAt          time.Time `json:"end_at" bson:"end_at"`
+		StartAnnounced bool      `json:"-" bson:"start_announced,omitempty"`
+		EndAnnounced   bool      `json:"-" bson:"end_announced,omitempty"`
+	}
+
+	LootBox struct {
+		DrawCount     int            `json:"draw_count" bson:"draw_count"`
+		PityThreshold int            `json:"pity_threshold" bson:"pity_threshold"`
+		Drops         []*LootBoxDrop `json:"drops" bson:"drops"`
+	}
+
+	LootBoxDrop struct {
+		ItemId string `json:"item_id" bson:"item_id"`
+		Weight int    `json:"weight" bson:"weight"`
+		IsRare bool   `json:"is_rare" bson:"is_rare"`
 	}
 )
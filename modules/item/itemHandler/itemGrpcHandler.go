@@ -23,3 +23,31 @@ func NewItemGrpcHandler(itemUsecase itemUsecase.ItemUsecaseService) *itemGrpcHan
 func (g *itemGrpcHandler) FindItemInIds(ctx context.Context, req *itemPb.FindItemInIdsReq) (*itemPb.FindItemInIdsRes, error) {
 	return g.itemUsecase.FindItemInIds(ctx, req)
 }
+
+func (g *itemGrpcHandler) FindOneLootBox(ctx context.Context, req *itemPb.FindOneLootBoxReq) (*itemPb.LootBox, error) {
+	return g.itemUsecase.FindOneLootBox(ctx, req)
+}
+
+func (g *itemGrpcHandler) WatchCatalog(req *itemPb.WatchCatalogReq, stream itemPb.ItemGrpcService_WatchCatalogServer) error {
+	return g.itemUsecase.WatchCatalog(stream.Context(), req, stream.Send)
+}
+
+func (g *itemGrpcHandler) ListItems(ctx context.Context, req *itemPb.ListItemsReq) (*itemPb.ListItemsRes, error) {
+	return g.itemUsecase.ListItems(ctx, req)
+}
+
+func (g *itemGrpcHandler) GetItemPrices(ctx context.Context, req *itemPb.GetItemPricesReq) (*itemPb.GetItemPricesRes, error) {
+	return g.itemUsecase.GetItemPrices(ctx, req)
+}
+
+func (g *itemGrpcHandler) GetRecipe(ctx context.Context, req *itemPb.GetRecipeReq) (*itemPb.Recipe, error) {
+	return g.itemUsecase.GetRecipe(ctx, req)
+}
+
+func (g *itemGrpcHandler) GetUpgradePath(ctx context.Context, req *itemPb.GetUpgradePathReq) (*itemPb.UpgradePath, error) {
+	return g.itemUsecase.GetUpgradePath(ctx, req)
+}
+
+func (g *itemGrpcHandler) CheckStorefront(ctx context.Context, req *itemPb.CheckStorefrontReq) (*itemPb.CheckStorefrontRes, error) {
+	return g.itemUsecase.CheckStorefront(ctx, req)
+}
@@ -1,14 +1,18 @@
 package itemHandler
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 
 	"github.com/Applessr/hello-sekai-shop-tutorial/config"
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/item"
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/item/itemUsecase"
+	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/blobstore"
 	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/request"
 	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/response"
 	"github.com/labstack/echo/v4"
@@ -19,8 +23,30 @@ type (
 		CreatedItem(c echo.Context) error
 		FindOneItem(c echo.Context) error
 		FindManyItem(c echo.Context) error
+		SuggestItems(c echo.Context) error
+		ImportItems(c echo.Context) error
+		ExportItems(c echo.Context) error
+		FindItemHistory(c echo.Context) error
+		RevertItem(c echo.Context) error
+		UploadImage(c echo.Context) error
+		FindImage(c echo.Context) error
 		EditItem(c echo.Context) error
 		EnableOrDisableItem(c echo.Context) error
+		UpdateLootBox(c echo.Context) error
+		FindLootBoxDropRates(c echo.Context) error
+		CreatePriceSchedule(c echo.Context) error
+		FindPriceSchedules(c echo.Context) error
+		DeletePriceSchedule(c echo.Context) error
+		UpdateUpgrades(c echo.Context) error
+		FindUpgradePath(c echo.Context) error
+		CreateRecipe(c echo.Context) error
+		FindRecipes(c echo.Context) error
+		FindOneRecipe(c echo.Context) error
+		EnableOrDisableRecipe(c echo.Context) error
+		FindStorefront(c echo.Context) error
+		CreateStorefrontRule(c echo.Context) error
+		FindStorefrontRules(c echo.Context) error
+		EnableOrDisableStorefrontRule(c echo.Context) error
 	}
 
 	itemHttpHandler struct {
@@ -44,7 +70,7 @@ func (h *itemHttpHandler) CreatedItem(c echo.Context) error {
 		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
 	}
 
-	res, err := h.itemUsecase.CreatedItem(ctx, req)
+	res, err := h.itemUsecase.CreatedItem(ctx, h.cfg, c.Get("player_id").(string), req)
 	if err != nil {
 		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
 	}
@@ -84,6 +110,89 @@ func (h *itemHttpHandler) FindManyItem(c echo.Context) error {
 	return response.SuccessResponse(c, http.StatusOK, res)
 }
 
+func (h *itemHttpHandler) SuggestItems(c echo.Context) error {
+	ctx := context.Background()
+
+	wrapper := request.ContextWrapper(c)
+
+	req := new(item.ItemSuggestReq)
+
+	if err := wrapper.Bind(req); err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	res, err := h.itemUsecase.SuggestItems(ctx, req)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, res)
+}
+
+func (h *itemHttpHandler) ImportItems(c echo.Context) error {
+	ctx := context.Background()
+
+	wrapper := request.ContextWrapper(c)
+
+	req := new(item.ItemImportReq)
+
+	if err := wrapper.Bind(req); err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, "error: file is required")
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+	defer file.Close()
+
+	rows, err := item.DecodeCatalog(req.Format, file)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	res, err := h.itemUsecase.ImportItems(ctx, h.cfg, c.Get("player_id").(string), rows, req.Upsert, req.DryRun)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, res)
+}
+
+func (h *itemHttpHandler) ExportItems(c echo.Context) error {
+	ctx := context.Background()
+
+	wrapper := request.ContextWrapper(c)
+
+	req := new(item.ItemExportReq)
+
+	if err := wrapper.Bind(req); err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	rows, err := h.itemUsecase.ExportItems(ctx)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusInternalServerError, err.Error())
+	}
+
+	buf := new(bytes.Buffer)
+	if err := item.EncodeCatalog(req.Format, buf, rows); err != nil {
+		return response.ErrResponse(c, http.StatusInternalServerError, err.Error())
+	}
+
+	contentType := "text/csv"
+	if req.Format == "json" {
+		contentType = echo.MIMEApplicationJSON
+	}
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=items.%s", req.Format))
+
+	return c.Blob(http.StatusOK, contentType, buf.Bytes())
+}
+
 func (h *itemHttpHandler) EditItem(c echo.Context) error {
 	ctx := context.Background()
 
@@ -96,7 +205,7 @@ func (h *itemHttpHandler) EditItem(c echo.Context) error {
 		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
 	}
 
-	res, err := h.itemUsecase.EditItem(ctx, itemId, req)
+	res, err := h.itemUsecase.EditItem(ctx, h.cfg, c.Get("player_id").(string), itemId, req)
 	if err != nil {
 		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
 	}
@@ -108,7 +217,7 @@ func (h *itemHttpHandler) EnableOrDisableItem(c echo.Context) error {
 
 	itemId := strings.TrimPrefix(c.Param("item_id"), "item:")
 
-	res, err := h.itemUsecase.EnableOrDisableItem(ctx, itemId)
+	res, err := h.itemUsecase.EnableOrDisableItem(ctx, h.cfg, c.Get("player_id").(string), itemId)
 	if err != nil {
 		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
 	}
@@ -117,3 +226,319 @@ func (h *itemHttpHandler) EnableOrDisableItem(c echo.Context) error {
 		"message": fmt.Sprintf("itemId: %s, status: %v", itemId, res),
 	})
 }
+
+func (h *itemHttpHandler) UpdateLootBox(c echo.Context) error {
+	ctx := context.Background()
+
+	itemId := strings.TrimPrefix(c.Param("item_id"), "item:")
+
+	wrapper := request.ContextWrapper(c)
+
+	req := &item.UpdateLootBoxReq{
+		Drops: make([]*item.LootBoxDropReq, 0),
+	}
+	if err := wrapper.Bind(req); err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	res, err := h.itemUsecase.UpdateLootBox(ctx, h.cfg, c.Get("player_id").(string), itemId, req)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, res)
+}
+
+func (h *itemHttpHandler) FindLootBoxDropRates(c echo.Context) error {
+	ctx := context.Background()
+
+	itemId := strings.TrimPrefix(c.Param("item_id"), "item:")
+
+	res, err := h.itemUsecase.FindLootBoxDropRates(ctx, itemId)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, res)
+}
+
+func (h *itemHttpHandler) CreatePriceSchedule(c echo.Context) error {
+	ctx := context.Background()
+
+	itemId := strings.TrimPrefix(c.Param("item_id"), "item:")
+
+	wrapper := request.ContextWrapper(c)
+
+	req := new(item.CreatePriceScheduleReq)
+	if err := wrapper.Bind(req); err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	res, err := h.itemUsecase.CreatePriceSchedule(ctx, h.cfg, c.Get("player_id").(string), itemId, req)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusCreated, res)
+}
+
+func (h *itemHttpHandler) FindPriceSchedules(c echo.Context) error {
+	ctx := context.Background()
+
+	itemId := strings.TrimPrefix(c.Param("item_id"), "item:")
+
+	res, err := h.itemUsecase.FindPriceSchedules(ctx, itemId)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, res)
+}
+
+func (h *itemHttpHandler) DeletePriceSchedule(c echo.Context) error {
+	ctx := context.Background()
+
+	itemId := strings.TrimPrefix(c.Param("item_id"), "item:")
+	scheduleId := c.Param("schedule_id")
+
+	res, err := h.itemUsecase.DeletePriceSchedule(ctx, h.cfg, c.Get("player_id").(string), itemId, scheduleId)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, res)
+}
+
+func (h *itemHttpHandler) FindItemHistory(c echo.Context) error {
+	ctx := context.Background()
+
+	itemId := strings.TrimPrefix(c.Param("item_id"), "item:")
+
+	res, err := h.itemUsecase.FindItemHistory(ctx, itemId)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, res)
+}
+
+func (h *itemHttpHandler) RevertItem(c echo.Context) error {
+	ctx := context.Background()
+
+	itemId := strings.TrimPrefix(c.Param("item_id"), "item:")
+	revisionId := c.Param("revision_id")
+
+	res, err := h.itemUsecase.RevertItem(ctx, h.cfg, c.Get("player_id").(string), itemId, revisionId)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, res)
+}
+
+func (h *itemHttpHandler) UploadImage(c echo.Context) error {
+	ctx := context.Background()
+
+	itemId := strings.TrimPrefix(c.Param("item_id"), "item:")
+
+	fileHeader, err := c.FormFile("image")
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, "error: image is required")
+	}
+	if fileHeader.Size > itemUsecase.MaxImageSize {
+		return response.ErrResponse(c, http.StatusRequestEntityTooLarge, "error: image is too large")
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, itemUsecase.MaxImageSize+1))
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	res, err := h.itemUsecase.UploadImage(ctx, h.cfg, c.Get("player_id").(string), itemId, data)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusCreated, res)
+}
+
+func (h *itemHttpHandler) FindImage(c echo.Context) error {
+	ctx := context.Background()
+
+	key := c.Param("*")
+
+	// Keys are content addressed so the key itself is a strong etag
+	etag := fmt.Sprintf("%q", key)
+	c.Response().Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	c.Response().Header().Set("ETag", etag)
+	if c.Request().Header.Get("If-None-Match") == etag {
+		return c.NoContent(http.StatusNotModified)
+	}
+
+	body, contentType, err := h.itemUsecase.FindImage(ctx, key)
+	if err != nil {
+		c.Response().Header().Del("Cache-Control")
+		c.Response().Header().Del("ETag")
+		if errors.Is(err, blobstore.ErrNotFound) {
+			return response.ErrResponse(c, http.StatusNotFound, err.Error())
+		}
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+	defer body.Close()
+
+	return c.Stream(http.StatusOK, contentType, body)
+}
+
+func (h *itemHttpHandler) UpdateUpgrades(c echo.Context) error {
+	ctx := context.Background()
+
+	itemId := strings.TrimPrefix(c.Param("item_id"), "item:")
+
+	wrapper := request.ContextWrapper(c)
+
+	req := &item.UpdateUpgradesReq{
+		Tiers: make([]*item.UpgradeTierReq, 0),
+	}
+	if err := wrapper.Bind(req); err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	res, err := h.itemUsecase.UpdateUpgrades(ctx, h.cfg, c.Get("player_id").(string), itemId, req)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, res)
+}
+
+func (h *itemHttpHandler) FindUpgradePath(c echo.Context) error {
+	ctx := context.Background()
+
+	itemId := strings.TrimPrefix(c.Param("item_id"), "item:")
+
+	res, err := h.itemUsecase.FindUpgradePath(ctx, itemId)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, res)
+}
+
+func (h *itemHttpHandler) CreateRecipe(c echo.Context) error {
+	ctx := context.Background()
+
+	wrapper := request.ContextWrapper(c)
+
+	req := new(item.CreateRecipeReq)
+	if err := wrapper.Bind(req); err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	res, err := h.itemUsecase.CreateRecipe(ctx, req)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusCreated, res)
+}
+
+func (h *itemHttpHandler) FindRecipes(c echo.Context) error {
+	ctx := context.Background()
+
+	res, err := h.itemUsecase.FindRecipes(ctx)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, res)
+}
+
+func (h *itemHttpHandler) FindOneRecipe(c echo.Context) error {
+	ctx := context.Background()
+
+	res, err := h.itemUsecase.FindOneRecipe(ctx, c.Param("recipe_id"))
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, res)
+}
+
+func (h *itemHttpHandler) EnableOrDisableRecipe(c echo.Context) error {
+	ctx := context.Background()
+
+	recipeId := c.Param("recipe_id")
+
+	res, err := h.itemUsecase.EnableOrDisableRecipe(ctx, recipeId)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, map[string]any{
+		"message": fmt.Sprintf("recipeId: %s, status: %v", recipeId, res),
+	})
+}
+
+func (h *itemHttpHandler) FindStorefront(c echo.Context) error {
+	ctx := context.Background()
+
+	res, err := h.itemUsecase.FindStorefront(ctx, c.Get("player_id").(string))
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, res)
+}
+
+func (h *itemHttpHandler) CreateStorefrontRule(c echo.Context) error {
+	ctx := context.Background()
+
+	wrapper := request.ContextWrapper(c)
+
+	req := &item.CreateStorefrontRuleReq{
+		Pool:     make([]string, 0),
+		Schedule: make([]*item.StorefrontRotationReq, 0),
+	}
+	if err := wrapper.Bind(req); err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	res, err := h.itemUsecase.CreateStorefrontRule(ctx, req)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusCreated, res)
+}
+
+func (h *itemHttpHandler) FindStorefrontRules(c echo.Context) error {
+	ctx := context.Background()
+
+	res, err := h.itemUsecase.FindStorefrontRules(ctx)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, res)
+}
+
+func (h *itemHttpHandler) EnableOrDisableStorefrontRule(c echo.Context) error {
+	ctx := context.Background()
+
+	ruleId := c.Param("rule_id")
+
+	res, err := h.itemUsecase.EnableOrDisableStorefrontRule(ctx, ruleId)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, map[string]any{
+		"message": fmt.Sprintf("ruleId: %s, status: %v", ruleId, res),
+	})
+}
@@ -0,0 +1,83 @@
+package itemHandler
+
+import (
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/Applessr/hello-sekai-shop-tutorial/config"
+	"github.com/Applessr/hello-sekai-shop-tutorial/modules/item"
+	"github.com/Applessr/hello-sekai-shop-tutorial/modules/item/itemUsecase"
+	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/queue"
+	"github.com/IBM/sarama"
+)
+
+type (
+	ItemQueueHandlerService interface {
+		WatchItemEvents()
+	}
+
+	itemQueueHandler struct {
+		cfg         *config.Config
+		itemUsecase itemUsecase.ItemUsecaseService
+	}
+)
+
+func NewItemQueueHandler(cfg *config.Config, itemUsecase itemUsecase.ItemUsecaseService) ItemQueueHandlerService {
+	return &itemQueueHandler{cfg, itemUsecase}
+}
+
+// WatchItemEvents feeds the WatchCatalog streams from the item topic, streams only follow live
+// changes so reading from now on is enough
+func (h *itemQueueHandler) WatchItemEvents() {
+	worker, err := queue.ConnectConsumer([]string{h.cfg.Kafka.Url}, h.cfg.Kafka.ApiKey, h.cfg.Kafka.Secret)
+	if err != nil {
+		return
+	}
+	defer worker.Close()
+
+	partitions, err := worker.Partitions("item")
+	if err != nil {
+		log.Println("Error: WatchItemEvents failed: ", err.Error())
+		return
+	}
+
+	messages := make(chan *sarama.ConsumerMessage)
+	for _, partition := range partitions {
+		consumer, err := worker.ConsumePartition("item", partition, sarama.OffsetNewest)
+		if err != nil {
+			log.Println("Error: WatchItemEvents failed: ", err.Error())
+			return
+		}
+		defer consumer.Close()
+
+		go func() {
+			for msg := range consumer.Messages() {
+				messages <- msg
+			}
+		}()
+	}
+
+	log.Println("Start WatchItemEvents ...")
+
+	sigchan := make(chan os.Signal, 1)
+	signal.Notify(sigchan, syscall.SIGINT, syscall.SIGTERM)
+
+	for {
+		select {
+		case msg := <-messages:
+			req := new(item.ItemEvent)
+			if err := queue.DecodeMessage(req, msg.Value); err != nil {
+				continue
+			}
+
+			h.itemUsecase.BroadcastItemEvent(req)
+
+			log.Printf("WatchItemEvents | %s broadcast %s v%d", req.Type, req.ItemId, req.Version)
+		case <-sigchan:
+			log.Println("Stop WatchItemEvents...")
+			return
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package itemHandler
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/Applessr/hello-sekai-shop-tutorial/config"
+	"github.com/Applessr/hello-sekai-shop-tutorial/modules/item/itemUsecase"
+)
+
+type (
+	ItemWorkerHandlerService interface {
+		AnnouncePriceSchedules()
+	}
+
+	itemWorkerHandler struct {
+		cfg         *config.Config
+		itemUsecase itemUsecase.ItemUsecaseService
+	}
+)
+
+func NewItemWorkerHandler(cfg *config.Config, itemUsecase itemUsecase.ItemUsecaseService) ItemWorkerHandlerService {
+	return &itemWorkerHandler{cfg, itemUsecase}
+}
+
+func (h *itemWorkerHandler) AnnouncePriceSchedules() {
+	ctx := context.Background()
+
+	ticker := time.NewTicker(time.Minute)
+	defer ticker.Stop()
+
+	log.Println("Start AnnouncePriceSchedules ...")
+
+	sigchan := make(chan os.Signal, 1)
+	signal.Notify(sigchan, syscall.SIGINT, syscall.SIGTERM)
+
+	for {
+		select {
+		case <-ticker.C:
+			h.itemUsecase.AnnouncePriceSchedules(ctx, h.cfg)
+		case <-sigchan:
+			log.Println("Stop AnnouncePriceSchedules...")
+			return
+		}
+	}
+}
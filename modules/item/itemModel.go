@@ -1,36 +1,278 @@
 package item
 
-import "github.com/Applessr/hello-sekai-shop-tutorial/modules/models"
+import (
+	"time"
+
+	"github.com/Applessr/hello-sekai-shop-tutorial/modules/models"
+)
 
 type (
 	CreateItemReq struct {
-		Title    string  `json:"title" validate:"required,max=64"`
-		Price    float64 `json:"price" validate:"required"`
-		ImageUrl string  `json:"image_url" validate:"required,max=255"`
-		Damage   int     `json:"damage" validate:"required"`
+		Title           string         `json:"title" validate:"required,max=64"`
+		Description     string         `json:"description" validate:"max=1024"`
+		Price           float64        `json:"price" validate:"required"`
+		ImageUrl        string         `json:"image_url" validate:"required,max=255"`
+		Damage          int            `json:"damage" validate:"required"`
+		BundleItems     []string       `json:"bundle_items" validate:"max=20,dive,required,max=64"`
+		Category        string         `json:"category" validate:"max=32"`
+		Rarity          string         `json:"rarity" validate:"omitempty,oneof=common uncommon rare epic legendary"`
+		Tags            []string       `json:"tags" validate:"max=20,dive,required,max=32"`
+		Attributes      map[string]any `json:"attributes" validate:"max=20"`
+		Stackable       bool           `json:"stackable"`
+		MaxStack        int            `json:"max_stack" validate:"min=0,max=9999"`
+		InventorySlots  int            `json:"inventory_slots" validate:"min=0,max=1000"`
+		EquipType       string         `json:"equip_type" validate:"omitempty,oneof=weapon armor accessory"`
+		DurationSeconds int64          `json:"duration_seconds" validate:"min=0"`
 	}
 
 	ItemShowCase struct {
-		ItemId   string  `json:"item_id"`
-		Title    string  `json:"title"`
-		Price    float64 `json:"price"`
-		Damage   int     `json:"damage"`
-		ImageUrl string  `json:"image_url"`
+		ItemId          string            `json:"item_id"`
+		Title           string            `json:"title"`
+		Description     string            `json:"description,omitempty"`
+		Price           float64           `json:"price"`
+		OriginalPrice   float64           `json:"original_price,omitempty"`
+		Damage          int               `json:"damage"`
+		ImageUrl        string            `json:"image_url"`
+		BundleItems     []string          `json:"bundle_items,omitempty"`
+		Category        string            `json:"category,omitempty"`
+		Rarity          string            `json:"rarity,omitempty"`
+		Tags            []string          `json:"tags,omitempty"`
+		Attributes      map[string]any    `json:"attributes,omitempty"`
+		Thumbnails      map[string]string `json:"thumbnails,omitempty"`
+		Stackable       bool              `json:"stackable"`
+		MaxStack        int               `json:"max_stack,omitempty"`
+		InventorySlots  int               `json:"inventory_slots,omitempty"`
+		EquipType       string            `json:"equip_type,omitempty"`
+		DurationSeconds int64             `json:"duration_seconds,omitempty"`
+		Version         int64             `json:"version"`
 	}
 
 	ItemSearchReq struct {
-		Title string `query:"title" validate:"max=64"`
+		Title     string   `query:"title" validate:"max=64"`
+		Q         string   `query:"q" validate:"max=128"`
+		Category  string   `query:"category" validate:"max=32"`
+		Rarity    string   `query:"rarity" validate:"omitempty,oneof=common uncommon rare epic legendary"`
+		Tags      []string `query:"tag" validate:"max=10,dive,max=32"`
+		Attrs     []string `query:"attr" validate:"max=10,dive,max=64"`
+		MinPrice  float64  `query:"min_price" validate:"min=0"`
+		MaxPrice  float64  `query:"max_price" validate:"min=0"`
+		MinDamage int      `query:"min_damage" validate:"min=0"`
+		MaxDamage int      `query:"max_damage" validate:"min=0"`
+		Sort      string   `query:"sort" validate:"omitempty,oneof=price_asc price_desc damage_asc damage_desc newest"`
 		models.PaginateReq
 	}
 
+	ItemImageRes struct {
+		ImageUrl   string            `json:"image_url"`
+		Thumbnails map[string]string `json:"thumbnails"`
+	}
+
+	ItemSuggestReq struct {
+		Q     string `query:"q" validate:"required,max=64"`
+		Limit int    `query:"limit" validate:"omitempty,min=1,max=10"`
+	}
+
+	ItemSuggestion struct {
+		ItemId string `json:"item_id"`
+		Title  string `json:"title"`
+	}
+
+	ItemSearchRes struct {
+		models.PaginateRes
+		Facets *ItemFacets `json:"facets"`
+	}
+
+	ItemFacets struct {
+		Categories []*FacetCount `json:"categories" bson:"categories"`
+		Rarities   []*FacetCount `json:"rarities" bson:"rarities"`
+		Tags       []*FacetCount `json:"tags" bson:"tags"`
+	}
+
+	FacetCount struct {
+		Value string `json:"value" bson:"_id"`
+		Count int64  `json:"count" bson:"count"`
+	}
+
 	ItemUpdateReq struct {
-		Title    string  `json:"title" validate:"required,max=64"`
-		Price    float64 `json:"price" validate:"required"`
-		ImageUrl string  `json:"image_url" validate:"required,max=255"`
-		Damage   int     `json:"damage" validate:"required"`
+		Title           string         `json:"title" validate:"required,max=64"`
+		Description     string         `json:"description" validate:"max=1024"`
+		Price           float64        `json:"price" validate:"required"`
+		ImageUrl        string         `json:"image_url" validate:"required,max=255"`
+		Damage          int            `json:"damage" validate:"required"`
+		Category        string         `json:"category" validate:"max=32"`
+		Rarity          string         `json:"rarity" validate:"omitempty,oneof=common uncommon rare epic legendary"`
+		Tags            []string       `json:"tags" validate:"max=20,dive,required,max=32"`
+		Attributes      map[string]any `json:"attributes" validate:"max=20"`
+		Stackable       *bool          `json:"stackable"`
+		MaxStack        int            `json:"max_stack" validate:"min=0,max=9999"`
+		InventorySlots  int            `json:"inventory_slots" validate:"min=0,max=1000"`
+		EquipType       string         `json:"equip_type" validate:"omitempty,oneof=weapon armor accessory"`
+		DurationSeconds int64          `json:"duration_seconds" validate:"min=0"`
 	}
 
 	EnableOrDisableItemReq struct {
 		UsageStatus bool `json:"status"`
 	}
+
+	CreatePriceScheduleReq struct {
+		Type    string    `json:"type" validate:"required,oneof=percent absolute"`
+		Value   float64   `json:"value" validate:"min=0"`
+		StartAt time.Time `json:"start_at" validate:"required"`
+		EndAt   time.Time `json:"end_at" validate:"required,gtfield=StartAt"`
+	}
+
+	UpdateLootBoxReq struct {
+		DrawCount     int               `json:"draw_count" validate:"required,min=1,max=10"`
+		PityThreshold int               `json:"pity_threshold" validate:"min=0"`
+		Drops         []*LootBoxDropReq `json:"drops" validate:"required,min=1,max=50,dive"`
+	}
+
+	LootBoxDropReq struct {
+		ItemId string `json:"item_id" validate:"required,max=64"`
+		Weight int    `json:"weight" validate:"required,min=1"`
+		IsRare bool   `json:"is_rare"`
+	}
+
+	UpdateUpgradesReq struct {
+		Tiers []*UpgradeTierReq `json:"tiers" validate:"max=20,dive"`
+	}
+
+	UpgradeTierReq struct {
+		Cost        float64 `json:"cost" validate:"min=0"`
+		Damage      int     `json:"damage" validate:"required,min=1"`
+		SuccessRate float64 `json:"success_rate" validate:"required,gt=0,lte=1"`
+	}
+
+	UpgradePathShowCase struct {
+		ItemId string         `json:"item_id"`
+		Title  string         `json:"title"`
+		Tiers  []*UpgradeTier `json:"tiers"`
+	}
+
+	CreateRecipeReq struct {
+		Title          string            `json:"title" validate:"required,max=64"`
+		Inputs         []*RecipeInputReq `json:"inputs" validate:"required,min=1,max=10,dive"`
+		Coins          float64           `json:"coins" validate:"min=0"`
+		OutputItemId   string            `json:"output_item_id" validate:"required,max=64"`
+		OutputQuantity int               `json:"output_quantity" validate:"required,min=1,max=100"`
+	}
+
+	RecipeInputReq struct {
+		ItemId   string `json:"item_id" validate:"required,max=64"`
+		Quantity int    `json:"quantity" validate:"required,min=1,max=100"`
+	}
+
+	RecipeShowCase struct {
+		RecipeId       string         `json:"recipe_id"`
+		Title          string         `json:"title"`
+		Inputs         []*RecipeInput `json:"inputs"`
+		Coins          float64        `json:"coins"`
+		OutputItemId   string         `json:"output_item_id"`
+		OutputQuantity int            `json:"output_quantity"`
+		UsageStatus    bool           `json:"usage_status"`
+	}
+
+	CreateStorefrontRuleReq struct {
+		Title    string                   `json:"title" validate:"required,max=64"`
+		Period   string                   `json:"period" validate:"required,oneof=daily weekly"`
+		Mode     string                   `json:"mode" validate:"required,oneof=random curated"`
+		Slots    int                      `json:"slots" validate:"max=20"`
+		Pool     []string                 `json:"pool" validate:"max=100,dive,required,max=64"`
+		Schedule []*StorefrontRotationReq `json:"schedule" validate:"max=60,dive"`
+	}
+
+	StorefrontRotationReq struct {
+		StartsAt string   `json:"starts_at" validate:"required,max=64"`
+		ItemIds  []string `json:"item_ids" validate:"required,min=1,max=20,dive,required,max=64"`
+	}
+
+	StorefrontSlot struct {
+		RuleId    string          `json:"rule_id"`
+		Title     string          `json:"title"`
+		Period    string          `json:"period"`
+		Items     []*ItemShowCase `json:"items"`
+		RefreshAt time.Time       `json:"refresh_at"`
+		RefreshIn int64           `json:"refresh_in"`
+	}
+
+	StorefrontRes struct {
+		Slots     []*StorefrontSlot `json:"slots"`
+		RefreshAt *time.Time        `json:"refresh_at,omitempty"`
+		RefreshIn int64             `json:"refresh_in"`
+	}
+
+	LootBoxDropRate struct {
+		ItemId string  `json:"item_id"`
+		Title  string  `json:"title"`
+		Weight int     `json:"weight"`
+		Rate   float64 `json:"rate"`
+		IsRare bool    `json:"is_rare"`
+	}
+
+	LootBoxShowCase struct {
+		ItemId        string             `json:"item_id"`
+		Title         string             `json:"title"`
+		DrawCount     int                `json:"draw_count"`
+		PityThreshold int                `json:"pity_threshold"`
+		Drops         []*LootBoxDropRate `json:"drops"`
+	}
+
+	CatalogRow struct {
+		Line            int            `json:"-"`
+		ParseError      string         `json:"-"`
+		Title           string         `json:"title" validate:"required,max=64"`
+		Description     string         `json:"description,omitempty" validate:"max=1024"`
+		Price           float64        `json:"price" validate:"required"`
+		Damage          int            `json:"damage" validate:"required"`
+		ImageUrl        string         `json:"image_url" validate:"required,max=255"`
+		Category        string         `json:"category,omitempty" validate:"max=32"`
+		Rarity          string         `json:"rarity,omitempty" validate:"omitempty,oneof=common uncommon rare epic legendary"`
+		Tags            []string       `json:"tags,omitempty" validate:"max=20,dive,required,max=32"`
+		Attributes      map[string]any `json:"attributes,omitempty" validate:"max=20"`
+		BundleItems     []string       `json:"bundle_items,omitempty" validate:"max=20,dive,required,max=64"`
+		Stackable       bool           `json:"stackable,omitempty"`
+		MaxStack        int            `json:"max_stack,omitempty" validate:"min=0,max=9999"`
+		InventorySlots  int            `json:"inventory_slots,omitempty" validate:"min=0,max=1000"`
+		EquipType       string         `json:"equip_type,omitempty" validate:"omitempty,oneof=weapon armor accessory"`
+		DurationSeconds int64          `json:"duration_seconds,omitempty" validate:"min=0"`
+		UsageStatus     *bool          `json:"usage_status,omitempty"`
+	}
+
+	ItemImportReq struct {
+		Format string `form:"format" validate:"required,oneof=csv json"`
+		Upsert bool   `form:"upsert"`
+		DryRun bool   `form:"dry_run"`
+	}
+
+	ItemExportReq struct {
+		Format string `query:"format" validate:"required,oneof=csv json"`
+	}
+
+	ItemImportRes struct {
+		DryRun    bool                   `json:"dry_run"`
+		Created   int                    `json:"created"`
+		Updated   int                    `json:"updated"`
+		Unchanged int                    `json:"unchanged"`
+		Failed    int                    `json:"failed"`
+		Rows      []*ItemImportRowResult `json:"rows"`
+	}
+
+	ItemImportRowResult struct {
+		Line    int            `json:"line"`
+		Title   string         `json:"title"`
+		ItemId  string         `json:"item_id,omitempty"`
+		Action  string         `json:"action"`
+		Changes []*FieldChange `json:"changes,omitempty"`
+		Error   string         `json:"error,omitempty"`
+	}
+
+	ItemRevisionShowCase struct {
+		RevisionId   string         `json:"revision_id"`
+		ItemId       string         `json:"item_id"`
+		Action       string         `json:"action"`
+		EditorId     string         `json:"editor_id"`
+		Changes      []*FieldChange `json:"changes"`
+		RevertedFrom string         `json:"reverted_from,omitempty"`
+		CreatedAt    time.Time      `json:"created_at"`
+	}
 )
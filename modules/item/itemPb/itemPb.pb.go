@@ -119,11 +119,22 @@ type Item struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title    string  `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Price    float64 `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
-	ImageUrl string  `protobuf:"bytes,4,opt,name=imageUrl,proto3" json:"imageUrl,omitempty"`
-	Damage   int32   `protobuf:"varint,5,opt,name=damage,proto3" json:"damage,omitempty"`
+	Id              string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title           string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Price           float64  `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	ImageUrl        string   `protobuf:"bytes,4,opt,name=imageUrl,proto3" json:"imageUrl,omitempty"`
+	Damage          int32    `protobuf:"varint,5,opt,name=damage,proto3" json:"damage,omitempty"`
+	BundleItems     []string `protobuf:"bytes,6,rep,name=bundleItems,proto3" json:"bundleItems,omitempty"`
+	OriginalPrice   float64  `protobuf:"fixed64,7,opt,name=originalPrice,proto3" json:"originalPrice,omitempty"`
+	Category        string   `protobuf:"bytes,8,opt,name=category,proto3" json:"category,omitempty"`
+	Rarity          string   `protobuf:"bytes,9,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Tags            []string `protobuf:"bytes,10,rep,name=tags,proto3" json:"tags,omitempty"`
+	Version         int64    `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`
+	Stackable       bool     `protobuf:"varint,12,opt,name=stackable,proto3" json:"stackable,omitempty"`
+	MaxStack        int32    `protobuf:"varint,13,opt,name=maxStack,proto3" json:"maxStack,omitempty"`
+	InventorySlots  int32    `protobuf:"varint,14,opt,name=inventorySlots,proto3" json:"inventorySlots,omitempty"`
+	EquipType       string   `protobuf:"bytes,15,opt,name=equipType,proto3" json:"equipType,omitempty"`
+	DurationSeconds int64    `protobuf:"varint,16,opt,name=durationSeconds,proto3" json:"durationSeconds,omitempty"`
 }
 
 func (x *Item) Reset() {
@@ -193,6 +204,1225 @@ func (x *Item) GetDamage() int32 {
 	return 0
 }
 
+func (x *Item) GetBundleItems() []string {
+	if x != nil {
+		return x.BundleItems
+	}
+	return nil
+}
+
+func (x *Item) GetOriginalPrice() float64 {
+	if x != nil {
+		return x.OriginalPrice
+	}
+	return 0
+}
+
+func (x *Item) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *Item) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *Item) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *Item) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Item) GetStackable() bool {
+	if x != nil {
+		return x.Stackable
+	}
+	return false
+}
+
+func (x *Item) GetMaxStack() int32 {
+	if x != nil {
+		return x.MaxStack
+	}
+	return 0
+}
+
+func (x *Item) GetInventorySlots() int32 {
+	if x != nil {
+		return x.InventorySlots
+	}
+	return 0
+}
+
+func (x *Item) GetEquipType() string {
+	if x != nil {
+		return x.EquipType
+	}
+	return ""
+}
+
+func (x *Item) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+type FindOneLootBoxReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *FindOneLootBoxReq) Reset() {
+	*x = FindOneLootBoxReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindOneLootBoxReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindOneLootBoxReq) ProtoMessage() {}
+
+func (x *FindOneLootBoxReq) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindOneLootBoxReq.ProtoReflect.Descriptor instead.
+func (*FindOneLootBoxReq) Descriptor() ([]byte, []int) {
+	return file_modules_item_itemPb_itemPb_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *FindOneLootBoxReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type LootBoxDrop struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemId string `protobuf:"bytes,1,opt,name=itemId,proto3" json:"itemId,omitempty"`
+	Weight int32  `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	IsRare bool   `protobuf:"varint,3,opt,name=isRare,proto3" json:"isRare,omitempty"`
+}
+
+func (x *LootBoxDrop) Reset() {
+	*x = LootBoxDrop{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LootBoxDrop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LootBoxDrop) ProtoMessage() {}
+
+func (x *LootBoxDrop) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LootBoxDrop.ProtoReflect.Descriptor instead.
+func (*LootBoxDrop) Descriptor() ([]byte, []int) {
+	return file_modules_item_itemPb_itemPb_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LootBoxDrop) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *LootBoxDrop) GetWeight() int32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *LootBoxDrop) GetIsRare() bool {
+	if x != nil {
+		return x.IsRare
+	}
+	return false
+}
+
+type LootBox struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string         `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	DrawCount     int32          `protobuf:"varint,3,opt,name=drawCount,proto3" json:"drawCount,omitempty"`
+	PityThreshold int32          `protobuf:"varint,4,opt,name=pityThreshold,proto3" json:"pityThreshold,omitempty"`
+	Drops         []*LootBoxDrop `protobuf:"bytes,5,rep,name=drops,proto3" json:"drops,omitempty"`
+}
+
+func (x *LootBox) Reset() {
+	*x = LootBox{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LootBox) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LootBox) ProtoMessage() {}
+
+func (x *LootBox) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LootBox.ProtoReflect.Descriptor instead.
+func (*LootBox) Descriptor() ([]byte, []int) {
+	return file_modules_item_itemPb_itemPb_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *LootBox) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *LootBox) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *LootBox) GetDrawCount() int32 {
+	if x != nil {
+		return x.DrawCount
+	}
+	return 0
+}
+
+func (x *LootBox) GetPityThreshold() int32 {
+	if x != nil {
+		return x.PityThreshold
+	}
+	return 0
+}
+
+func (x *LootBox) GetDrops() []*LootBoxDrop {
+	if x != nil {
+		return x.Drops
+	}
+	return nil
+}
+
+type WatchCatalogReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IncludeSnapshot bool `protobuf:"varint,1,opt,name=includeSnapshot,proto3" json:"includeSnapshot,omitempty"`
+}
+
+func (x *WatchCatalogReq) Reset() {
+	*x = WatchCatalogReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchCatalogReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchCatalogReq) ProtoMessage() {}
+
+func (x *WatchCatalogReq) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchCatalogReq.ProtoReflect.Descriptor instead.
+func (*WatchCatalogReq) Descriptor() ([]byte, []int) {
+	return file_modules_item_itemPb_itemPb_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *WatchCatalogReq) GetIncludeSnapshot() bool {
+	if x != nil {
+		return x.IncludeSnapshot
+	}
+	return false
+}
+
+type CatalogEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type       string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Version    int64  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	IsActive   bool   `protobuf:"varint,3,opt,name=isActive,proto3" json:"isActive,omitempty"`
+	Item       *Item  `protobuf:"bytes,4,opt,name=item,proto3" json:"item,omitempty"`
+	OccurredAt string `protobuf:"bytes,5,opt,name=occurredAt,proto3" json:"occurredAt,omitempty"`
+}
+
+func (x *CatalogEvent) Reset() {
+	*x = CatalogEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CatalogEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CatalogEvent) ProtoMessage() {}
+
+func (x *CatalogEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CatalogEvent.ProtoReflect.Descriptor instead.
+func (*CatalogEvent) Descriptor() ([]byte, []int) {
+	return file_modules_item_itemPb_itemPb_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *CatalogEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *CatalogEvent) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *CatalogEvent) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *CatalogEvent) GetItem() *Item {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *CatalogEvent) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+type ListItemsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start     string   `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	Limit     int32    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Title     string   `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Q         string   `protobuf:"bytes,4,opt,name=q,proto3" json:"q,omitempty"`
+	Category  string   `protobuf:"bytes,5,opt,name=category,proto3" json:"category,omitempty"`
+	Rarity    string   `protobuf:"bytes,6,opt,name=rarity,proto3" json:"rarity,omitempty"`
+	Tags      []string `protobuf:"bytes,7,rep,name=tags,proto3" json:"tags,omitempty"`
+	MinPrice  float64  `protobuf:"fixed64,8,opt,name=minPrice,proto3" json:"minPrice,omitempty"`
+	MaxPrice  float64  `protobuf:"fixed64,9,opt,name=maxPrice,proto3" json:"maxPrice,omitempty"`
+	MinDamage int32    `protobuf:"varint,10,opt,name=minDamage,proto3" json:"minDamage,omitempty"`
+	MaxDamage int32    `protobuf:"varint,11,opt,name=maxDamage,proto3" json:"maxDamage,omitempty"`
+	Sort      string   `protobuf:"bytes,12,opt,name=sort,proto3" json:"sort,omitempty"`
+}
+
+func (x *ListItemsReq) Reset() {
+	*x = ListItemsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListItemsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListItemsReq) ProtoMessage() {}
+
+func (x *ListItemsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListItemsReq.ProtoReflect.Descriptor instead.
+func (*ListItemsReq) Descriptor() ([]byte, []int) {
+	return file_modules_item_itemPb_itemPb_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListItemsReq) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *ListItemsReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListItemsReq) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ListItemsReq) GetQ() string {
+	if x != nil {
+		return x.Q
+	}
+	return ""
+}
+
+func (x *ListItemsReq) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *ListItemsReq) GetRarity() string {
+	if x != nil {
+		return x.Rarity
+	}
+	return ""
+}
+
+func (x *ListItemsReq) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *ListItemsReq) GetMinPrice() float64 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
+
+func (x *ListItemsReq) GetMaxPrice() float64 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
+
+func (x *ListItemsReq) GetMinDamage() int32 {
+	if x != nil {
+		return x.MinDamage
+	}
+	return 0
+}
+
+func (x *ListItemsReq) GetMaxDamage() int32 {
+	if x != nil {
+		return x.MaxDamage
+	}
+	return 0
+}
+
+func (x *ListItemsReq) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+type ListItemsRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*Item `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	Total int64   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Next  string  `protobuf:"bytes,3,opt,name=next,proto3" json:"next,omitempty"`
+}
+
+func (x *ListItemsRes) Reset() {
+	*x = ListItemsRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListItemsRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListItemsRes) ProtoMessage() {}
+
+func (x *ListItemsRes) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListItemsRes.ProtoReflect.Descriptor instead.
+func (*ListItemsRes) Descriptor() ([]byte, []int) {
+	return file_modules_item_itemPb_itemPb_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ListItemsRes) GetItems() []*Item {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListItemsRes) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListItemsRes) GetNext() string {
+	if x != nil {
+		return x.Next
+	}
+	return ""
+}
+
+type GetItemPricesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *GetItemPricesReq) Reset() {
+	*x = GetItemPricesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetItemPricesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemPricesReq) ProtoMessage() {}
+
+func (x *GetItemPricesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemPricesReq.ProtoReflect.Descriptor instead.
+func (*GetItemPricesReq) Descriptor() ([]byte, []int) {
+	return file_modules_item_itemPb_itemPb_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetItemPricesReq) GetIds() []string {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type ItemPrice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Price         float64 `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	OriginalPrice float64 `protobuf:"fixed64,3,opt,name=originalPrice,proto3" json:"originalPrice,omitempty"`
+	PriceVersion  string  `protobuf:"bytes,4,opt,name=priceVersion,proto3" json:"priceVersion,omitempty"`
+}
+
+func (x *ItemPrice) Reset() {
+	*x = ItemPrice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ItemPrice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ItemPrice) ProtoMessage() {}
+
+func (x *ItemPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ItemPrice.ProtoReflect.Descriptor instead.
+func (*ItemPrice) Descriptor() ([]byte, []int) {
+	return file_modules_item_itemPb_itemPb_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ItemPrice) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ItemPrice) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *ItemPrice) GetOriginalPrice() float64 {
+	if x != nil {
+		return x.OriginalPrice
+	}
+	return 0
+}
+
+func (x *ItemPrice) GetPriceVersion() string {
+	if x != nil {
+		return x.PriceVersion
+	}
+	return ""
+}
+
+type GetItemPricesRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Prices       []*ItemPrice `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices,omitempty"`
+	PriceVersion string       `protobuf:"bytes,2,opt,name=priceVersion,proto3" json:"priceVersion,omitempty"`
+}
+
+func (x *GetItemPricesRes) Reset() {
+	*x = GetItemPricesRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetItemPricesRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetItemPricesRes) ProtoMessage() {}
+
+func (x *GetItemPricesRes) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetItemPricesRes.ProtoReflect.Descriptor instead.
+func (*GetItemPricesRes) Descriptor() ([]byte, []int) {
+	return file_modules_item_itemPb_itemPb_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetItemPricesRes) GetPrices() []*ItemPrice {
+	if x != nil {
+		return x.Prices
+	}
+	return nil
+}
+
+func (x *GetItemPricesRes) GetPriceVersion() string {
+	if x != nil {
+		return x.PriceVersion
+	}
+	return ""
+}
+
+type GetRecipeReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetRecipeReq) Reset() {
+	*x = GetRecipeReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRecipeReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecipeReq) ProtoMessage() {}
+
+func (x *GetRecipeReq) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecipeReq.ProtoReflect.Descriptor instead.
+func (*GetRecipeReq) Descriptor() ([]byte, []int) {
+	return file_modules_item_itemPb_itemPb_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetRecipeReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RecipeInput struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemId   string `protobuf:"bytes,1,opt,name=itemId,proto3" json:"itemId,omitempty"`
+	Quantity int32  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+}
+
+func (x *RecipeInput) Reset() {
+	*x = RecipeInput{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecipeInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeInput) ProtoMessage() {}
+
+func (x *RecipeInput) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeInput.ProtoReflect.Descriptor instead.
+func (*RecipeInput) Descriptor() ([]byte, []int) {
+	return file_modules_item_itemPb_itemPb_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RecipeInput) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *RecipeInput) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type Recipe struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title          string         `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Inputs         []*RecipeInput `protobuf:"bytes,3,rep,name=inputs,proto3" json:"inputs,omitempty"`
+	Coins          float64        `protobuf:"fixed64,4,opt,name=coins,proto3" json:"coins,omitempty"`
+	OutputItemId   string         `protobuf:"bytes,5,opt,name=outputItemId,proto3" json:"outputItemId,omitempty"`
+	OutputQuantity int32          `protobuf:"varint,6,opt,name=outputQuantity,proto3" json:"outputQuantity,omitempty"`
+}
+
+func (x *Recipe) Reset() {
+	*x = Recipe{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Recipe) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Recipe) ProtoMessage() {}
+
+func (x *Recipe) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Recipe.ProtoReflect.Descriptor instead.
+func (*Recipe) Descriptor() ([]byte, []int) {
+	return file_modules_item_itemPb_itemPb_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Recipe) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Recipe) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Recipe) GetInputs() []*RecipeInput {
+	if x != nil {
+		return x.Inputs
+	}
+	return nil
+}
+
+func (x *Recipe) GetCoins() float64 {
+	if x != nil {
+		return x.Coins
+	}
+	return 0
+}
+
+func (x *Recipe) GetOutputItemId() string {
+	if x != nil {
+		return x.OutputItemId
+	}
+	return ""
+}
+
+func (x *Recipe) GetOutputQuantity() int32 {
+	if x != nil {
+		return x.OutputQuantity
+	}
+	return 0
+}
+
+type GetUpgradePathReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetUpgradePathReq) Reset() {
+	*x = GetUpgradePathReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUpgradePathReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUpgradePathReq) ProtoMessage() {}
+
+func (x *GetUpgradePathReq) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUpgradePathReq.ProtoReflect.Descriptor instead.
+func (*GetUpgradePathReq) Descriptor() ([]byte, []int) {
+	return file_modules_item_itemPb_itemPb_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetUpgradePathReq) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpgradeTier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cost        float64 `protobuf:"fixed64,1,opt,name=cost,proto3" json:"cost,omitempty"`
+	Damage      int32   `protobuf:"varint,2,opt,name=damage,proto3" json:"damage,omitempty"`
+	SuccessRate float64 `protobuf:"fixed64,3,opt,name=successRate,proto3" json:"successRate,omitempty"`
+}
+
+func (x *UpgradeTier) Reset() {
+	*x = UpgradeTier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpgradeTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradeTier) ProtoMessage() {}
+
+func (x *UpgradeTier) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradeTier.ProtoReflect.Descriptor instead.
+func (*UpgradeTier) Descriptor() ([]byte, []int) {
+	return file_modules_item_itemPb_itemPb_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *UpgradeTier) GetCost() float64 {
+	if x != nil {
+		return x.Cost
+	}
+	return 0
+}
+
+func (x *UpgradeTier) GetDamage() int32 {
+	if x != nil {
+		return x.Damage
+	}
+	return 0
+}
+
+func (x *UpgradeTier) GetSuccessRate() float64 {
+	if x != nil {
+		return x.SuccessRate
+	}
+	return 0
+}
+
+type UpgradePath struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Tiers []*UpgradeTier `protobuf:"bytes,2,rep,name=tiers,proto3" json:"tiers,omitempty"`
+}
+
+func (x *UpgradePath) Reset() {
+	*x = UpgradePath{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpgradePath) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradePath) ProtoMessage() {}
+
+func (x *UpgradePath) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpgradePath.ProtoReflect.Descriptor instead.
+func (*UpgradePath) Descriptor() ([]byte, []int) {
+	return file_modules_item_itemPb_itemPb_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpgradePath) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpgradePath) GetTiers() []*UpgradeTier {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+type CheckStorefrontReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string   `protobuf:"bytes,1,opt,name=playerId,proto3" json:"playerId,omitempty"`
+	ItemIds  []string `protobuf:"bytes,2,rep,name=itemIds,proto3" json:"itemIds,omitempty"`
+}
+
+func (x *CheckStorefrontReq) Reset() {
+	*x = CheckStorefrontReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckStorefrontReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckStorefrontReq) ProtoMessage() {}
+
+func (x *CheckStorefrontReq) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckStorefrontReq.ProtoReflect.Descriptor instead.
+func (*CheckStorefrontReq) Descriptor() ([]byte, []int) {
+	return file_modules_item_itemPb_itemPb_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CheckStorefrontReq) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *CheckStorefrontReq) GetItemIds() []string {
+	if x != nil {
+		return x.ItemIds
+	}
+	return nil
+}
+
+type CheckStorefrontRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Blocked []string `protobuf:"bytes,1,rep,name=blocked,proto3" json:"blocked,omitempty"`
+}
+
+func (x *CheckStorefrontRes) Reset() {
+	*x = CheckStorefrontRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckStorefrontRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckStorefrontRes) ProtoMessage() {}
+
+func (x *CheckStorefrontRes) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_item_itemPb_itemPb_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckStorefrontRes.ProtoReflect.Descriptor instead.
+func (*CheckStorefrontRes) Descriptor() ([]byte, []int) {
+	return file_modules_item_itemPb_itemPb_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CheckStorefrontRes) GetBlocked() []string {
+	if x != nil {
+		return x.Blocked
+	}
+	return nil
+}
+
 var File_modules_item_itemPb_itemPb_proto protoreflect.FileDescriptor
 
 var file_modules_item_itemPb_itemPb_proto_rawDesc = []byte{
@@ -203,23 +1433,175 @@ var file_modules_item_itemPb_itemPb_proto_rawDesc = []byte{
 	0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x2f, 0x0a, 0x10, 0x46, 0x69, 0x6e, 0x64,
 	0x49, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x05,
 	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x49, 0x74,
-	0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0x76, 0x0a, 0x04, 0x49, 0x74, 0x65,
-	0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x61, 0x6d,
-	0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x64, 0x61, 0x6d, 0x61, 0x67,
-	0x65, 0x32, 0x48, 0x0a, 0x0f, 0x69, 0x74, 0x65, 0x6d, 0x47, 0x72, 0x70, 0x63, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x12, 0x35, 0x0a, 0x0d, 0x46, 0x69, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d,
-	0x49, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x11, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d,
-	0x49, 0x6e, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x49,
-	0x74, 0x65, 0x6d, 0x49, 0x6e, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x42, 0x2f, 0x5a, 0x2d, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x73,
-	0x73, 0x72, 0x2f, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x2d, 0x73, 0x65, 0x6b, 0x61, 0x69, 0x2d, 0x73,
-	0x68, 0x6f, 0x70, 0x2d, 0x74, 0x75, 0x74, 0x6f, 0x72, 0x69, 0x61, 0x6c, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x22, 0xca, 0x03, 0x0a, 0x04, 0x49, 0x74,
+	0x65, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x61,
+	0x6d, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x64, 0x61, 0x6d, 0x61,
+	0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x49, 0x74, 0x65, 0x6d,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x49,
+	0x74, 0x65, 0x6d, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c,
+	0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61,
+	0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x74, 0x61, 0x63, 0x6b, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61,
+	0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61,
+	0x78, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74,
+	0x6f, 0x72, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e,
+	0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x65, 0x71, 0x75, 0x69, 0x70, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x65, 0x71, 0x75, 0x69, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x0a, 0x0f,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x23, 0x0a, 0x11, 0x46, 0x69, 0x6e, 0x64, 0x4f, 0x6e,
+	0x65, 0x4c, 0x6f, 0x6f, 0x74, 0x42, 0x6f, 0x78, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x55, 0x0a, 0x0b, 0x4c,
+	0x6f, 0x6f, 0x74, 0x42, 0x6f, 0x78, 0x44, 0x72, 0x6f, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x74,
+	0x65, 0x6d, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73,
+	0x52, 0x61, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x52, 0x61,
+	0x72, 0x65, 0x22, 0x97, 0x01, 0x0a, 0x07, 0x4c, 0x6f, 0x6f, 0x74, 0x42, 0x6f, 0x78, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x72, 0x61, 0x77, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x64, 0x72, 0x61, 0x77, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x69, 0x74, 0x79, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x70, 0x69, 0x74, 0x79, 0x54,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x22, 0x0a, 0x05, 0x64, 0x72, 0x6f, 0x70,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x4c, 0x6f, 0x6f, 0x74, 0x42, 0x6f,
+	0x78, 0x44, 0x72, 0x6f, 0x70, 0x52, 0x05, 0x64, 0x72, 0x6f, 0x70, 0x73, 0x22, 0x3b, 0x0a, 0x0f,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x71, 0x12,
+	0x28, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x22, 0x93, 0x01, 0x0a, 0x0c, 0x43, 0x61,
+	0x74, 0x61, 0x6c, 0x6f, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x41, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x12, 0x19, 0x0a, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x05, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x04, 0x69, 0x74, 0x65, 0x6d, 0x12,
+	0x1e, 0x0a, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6f, 0x63, 0x63, 0x75, 0x72, 0x72, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0xae, 0x02, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x71,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x61,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x69, 0x6e, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x6d, 0x61, 0x78, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x6f, 0x72, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6f, 0x72, 0x74,
+	0x22, 0x55, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73,
+	0x12, 0x1b, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x05, 0x2e, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x65, 0x78, 0x74, 0x22, 0x24, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x49, 0x74,
+	0x65, 0x6d, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03, 0x69,
+	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x7b, 0x0a,
+	0x09, 0x49, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61,
+	0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x5a, 0x0a, 0x10, 0x47, 0x65,
+	0x74, 0x49, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x12, 0x22,
+	0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0a,
+	0x2e, 0x49, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x69, 0x63, 0x65, 0x52, 0x06, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x1e, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x41, 0x0a, 0x0b, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0xb6, 0x01, 0x0a, 0x06, 0x52, 0x65,
+	0x63, 0x69, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x06, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x52, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x05, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0e, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x22, 0x23, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x5b, 0x0a, 0x0b, 0x55, 0x70, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x54, 0x69, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x63, 0x6f, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x61,
+	0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x64, 0x61, 0x6d, 0x61,
+	0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x52, 0x61, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x52, 0x61, 0x74, 0x65, 0x22, 0x41, 0x0a, 0x0b, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x05, 0x74, 0x69, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x54, 0x69, 0x65, 0x72,
+	0x52, 0x05, 0x74, 0x69, 0x65, 0x72, 0x73, 0x22, 0x4a, 0x0a, 0x12, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x74, 0x65,
+	0x6d, 0x49, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x69, 0x74, 0x65, 0x6d,
+	0x49, 0x64, 0x73, 0x22, 0x2e, 0x0a, 0x12, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x65, 0x64, 0x32, 0xa3, 0x03, 0x0a, 0x0f, 0x69, 0x74, 0x65, 0x6d, 0x47, 0x72, 0x70, 0x63,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x35, 0x0a, 0x0d, 0x46, 0x69, 0x6e, 0x64, 0x49,
+	0x74, 0x65, 0x6d, 0x49, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x11, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x49,
+	0x74, 0x65, 0x6d, 0x49, 0x6e, 0x49, 0x64, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x11, 0x2e, 0x46, 0x69,
+	0x6e, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x49, 0x64, 0x73, 0x52, 0x65, 0x73, 0x12, 0x2e,
+	0x0a, 0x0e, 0x46, 0x69, 0x6e, 0x64, 0x4f, 0x6e, 0x65, 0x4c, 0x6f, 0x6f, 0x74, 0x42, 0x6f, 0x78,
+	0x12, 0x12, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4f, 0x6e, 0x65, 0x4c, 0x6f, 0x6f, 0x74, 0x42, 0x6f,
+	0x78, 0x52, 0x65, 0x71, 0x1a, 0x08, 0x2e, 0x4c, 0x6f, 0x6f, 0x74, 0x42, 0x6f, 0x78, 0x12, 0x31,
+	0x0a, 0x0c, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x12, 0x10,
+	0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x52, 0x65, 0x71,
+	0x1a, 0x0d, 0x2e, 0x43, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30,
+	0x01, 0x12, 0x29, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x0d,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0d,
+	0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x11, 0x2e,
+	0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x1a, 0x11, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
+	0x12, 0x0d, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x71, 0x1a,
+	0x07, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x12, 0x32, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55,
+	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x12, 0x2e, 0x47, 0x65, 0x74,
+	0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x1a, 0x0c,
+	0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x3b, 0x0a, 0x0f,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x12,
+	0x13, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x66, 0x72, 0x6f, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x1a, 0x13, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x74, 0x6f, 0x72,
+	0x65, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x42, 0x2f, 0x5a, 0x2d, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x70, 0x70, 0x6c, 0x65, 0x73, 0x73, 0x72,
+	0x2f, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x2d, 0x73, 0x65, 0x6b, 0x61, 0x69, 0x2d, 0x73, 0x68, 0x6f,
+	0x70, 0x2d, 0x74, 0x75, 0x74, 0x6f, 0x72, 0x69, 0x61, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -234,21 +1616,59 @@ func file_modules_item_itemPb_itemPb_proto_rawDescGZIP() []byte {
 	return file_modules_item_itemPb_itemPb_proto_rawDescData
 }
 
-var file_modules_item_itemPb_itemPb_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_modules_item_itemPb_itemPb_proto_msgTypes = make([]protoimpl.MessageInfo, 21)
 var file_modules_item_itemPb_itemPb_proto_goTypes = []interface{}{
-	(*FindItemInIdsReq)(nil), // 0: FindItemInIdsReq
-	(*FindItemInIdsRes)(nil), // 1: FindItemInIdsRes
-	(*Item)(nil),             // 2: Item
+	(*FindItemInIdsReq)(nil),   // 0: FindItemInIdsReq
+	(*FindItemInIdsRes)(nil),   // 1: FindItemInIdsRes
+	(*Item)(nil),               // 2: Item
+	(*FindOneLootBoxReq)(nil),  // 3: FindOneLootBoxReq
+	(*LootBoxDrop)(nil),        // 4: LootBoxDrop
+	(*LootBox)(nil),            // 5: LootBox
+	(*WatchCatalogReq)(nil),    // 6: WatchCatalogReq
+	(*CatalogEvent)(nil),       // 7: CatalogEvent
+	(*ListItemsReq)(nil),       // 8: ListItemsReq
+	(*ListItemsRes)(nil),       // 9: ListItemsRes
+	(*GetItemPricesReq)(nil),   // 10: GetItemPricesReq
+	(*ItemPrice)(nil),          // 11: ItemPrice
+	(*GetItemPricesRes)(nil),   // 12: GetItemPricesRes
+	(*GetRecipeReq)(nil),       // 13: GetRecipeReq
+	(*RecipeInput)(nil),        // 14: RecipeInput
+	(*Recipe)(nil),             // 15: Recipe
+	(*GetUpgradePathReq)(nil),  // 16: GetUpgradePathReq
+	(*UpgradeTier)(nil),        // 17: UpgradeTier
+	(*UpgradePath)(nil),        // 18: UpgradePath
+	(*CheckStorefrontReq)(nil), // 19: CheckStorefrontReq
+	(*CheckStorefrontRes)(nil), // 20: CheckStorefrontRes
 }
 var file_modules_item_itemPb_itemPb_proto_depIdxs = []int32{
-	2, // 0: FindItemInIdsRes.items:type_name -> Item
-	0, // 1: itemGrpcService.FindItemInIds:input_type -> FindItemInIdsReq
-	1, // 2: itemGrpcService.FindItemInIds:output_type -> FindItemInIdsRes
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2,  // 0: FindItemInIdsRes.items:type_name -> Item
+	4,  // 1: LootBox.drops:type_name -> LootBoxDrop
+	2,  // 2: CatalogEvent.item:type_name -> Item
+	2,  // 3: ListItemsRes.items:type_name -> Item
+	11, // 4: GetItemPricesRes.prices:type_name -> ItemPrice
+	14, // 5: Recipe.inputs:type_name -> RecipeInput
+	17, // 6: UpgradePath.tiers:type_name -> UpgradeTier
+	0,  // 7: itemGrpcService.FindItemInIds:input_type -> FindItemInIdsReq
+	3,  // 8: itemGrpcService.FindOneLootBox:input_type -> FindOneLootBoxReq
+	6,  // 9: itemGrpcService.WatchCatalog:input_type -> WatchCatalogReq
+	8,  // 10: itemGrpcService.ListItems:input_type -> ListItemsReq
+	10, // 11: itemGrpcService.GetItemPrices:input_type -> GetItemPricesReq
+	13, // 12: itemGrpcService.GetRecipe:input_type -> GetRecipeReq
+	16, // 13: itemGrpcService.GetUpgradePath:input_type -> GetUpgradePathReq
+	19, // 14: itemGrpcService.CheckStorefront:input_type -> CheckStorefrontReq
+	1,  // 15: itemGrpcService.FindItemInIds:output_type -> FindItemInIdsRes
+	5,  // 16: itemGrpcService.FindOneLootBox:output_type -> LootBox
+	7,  // 17: itemGrpcService.WatchCatalog:output_type -> CatalogEvent
+	9,  // 18: itemGrpcService.ListItems:output_type -> ListItemsRes
+	12, // 19: itemGrpcService.GetItemPrices:output_type -> GetItemPricesRes
+	15, // 20: itemGrpcService.GetRecipe:output_type -> Recipe
+	18, // 21: itemGrpcService.GetUpgradePath:output_type -> UpgradePath
+	20, // 22: itemGrpcService.CheckStorefront:output_type -> CheckStorefrontRes
+	15, // [15:23] is the sub-list for method output_type
+	7,  // [7:15] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_modules_item_itemPb_itemPb_proto_init() }
@@ -293,6 +1713,222 @@ func file_modules_item_itemPb_itemPb_proto_init() {
 				return nil
 			}
 		}
+		file_modules_item_itemPb_itemPb_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindOneLootBoxReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_item_itemPb_itemPb_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LootBoxDrop); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_item_itemPb_itemPb_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LootBox); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_item_itemPb_itemPb_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchCatalogReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_item_itemPb_itemPb_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CatalogEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_item_itemPb_itemPb_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListItemsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_item_itemPb_itemPb_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListItemsRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_item_itemPb_itemPb_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetItemPricesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_item_itemPb_itemPb_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ItemPrice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_item_itemPb_itemPb_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetItemPricesRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_item_itemPb_itemPb_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRecipeReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_item_itemPb_itemPb_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecipeInput); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_item_itemPb_itemPb_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Recipe); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_item_itemPb_itemPb_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUpgradePathReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_item_itemPb_itemPb_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpgradeTier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_item_itemPb_itemPb_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpgradePath); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_item_itemPb_itemPb_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckStorefrontReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_item_itemPb_itemPb_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckStorefrontRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -300,7 +1936,7 @@ func file_modules_item_itemPb_itemPb_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_modules_item_itemPb_itemPb_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   21,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
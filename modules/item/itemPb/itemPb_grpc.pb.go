@@ -23,6 +23,13 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ItemGrpcServiceClient interface {
 	FindItemInIds(ctx context.Context, in *FindItemInIdsReq, opts ...grpc.CallOption) (*FindItemInIdsRes, error)
+	FindOneLootBox(ctx context.Context, in *FindOneLootBoxReq, opts ...grpc.CallOption) (*LootBox, error)
+	WatchCatalog(ctx context.Context, in *WatchCatalogReq, opts ...grpc.CallOption) (ItemGrpcService_WatchCatalogClient, error)
+	ListItems(ctx context.Context, in *ListItemsReq, opts ...grpc.CallOption) (*ListItemsRes, error)
+	GetItemPrices(ctx context.Context, in *GetItemPricesReq, opts ...grpc.CallOption) (*GetItemPricesRes, error)
+	GetRecipe(ctx context.Context, in *GetRecipeReq, opts ...grpc.CallOption) (*Recipe, error)
+	GetUpgradePath(ctx context.Context, in *GetUpgradePathReq, opts ...grpc.CallOption) (*UpgradePath, error)
+	CheckStorefront(ctx context.Context, in *CheckStorefrontReq, opts ...grpc.CallOption) (*CheckStorefrontRes, error)
 }
 
 type itemGrpcServiceClient struct {
@@ -42,11 +49,104 @@ func (c *itemGrpcServiceClient) FindItemInIds(ctx context.Context, in *FindItemI
 	return out, nil
 }
 
+func (c *itemGrpcServiceClient) FindOneLootBox(ctx context.Context, in *FindOneLootBoxReq, opts ...grpc.CallOption) (*LootBox, error) {
+	out := new(LootBox)
+	err := c.cc.Invoke(ctx, "/itemGrpcService/FindOneLootBox", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemGrpcServiceClient) WatchCatalog(ctx context.Context, in *WatchCatalogReq, opts ...grpc.CallOption) (ItemGrpcService_WatchCatalogClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ItemGrpcService_ServiceDesc.Streams[0], "/itemGrpcService/WatchCatalog", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &itemGrpcServiceWatchCatalogClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ItemGrpcService_WatchCatalogClient interface {
+	Recv() (*CatalogEvent, error)
+	grpc.ClientStream
+}
+
+type itemGrpcServiceWatchCatalogClient struct {
+	grpc.ClientStream
+}
+
+func (x *itemGrpcServiceWatchCatalogClient) Recv() (*CatalogEvent, error) {
+	m := new(CatalogEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *itemGrpcServiceClient) ListItems(ctx context.Context, in *ListItemsReq, opts ...grpc.CallOption) (*ListItemsRes, error) {
+	out := new(ListItemsRes)
+	err := c.cc.Invoke(ctx, "/itemGrpcService/ListItems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemGrpcServiceClient) GetItemPrices(ctx context.Context, in *GetItemPricesReq, opts ...grpc.CallOption) (*GetItemPricesRes, error) {
+	out := new(GetItemPricesRes)
+	err := c.cc.Invoke(ctx, "/itemGrpcService/GetItemPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemGrpcServiceClient) GetRecipe(ctx context.Context, in *GetRecipeReq, opts ...grpc.CallOption) (*Recipe, error) {
+	out := new(Recipe)
+	err := c.cc.Invoke(ctx, "/itemGrpcService/GetRecipe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemGrpcServiceClient) GetUpgradePath(ctx context.Context, in *GetUpgradePathReq, opts ...grpc.CallOption) (*UpgradePath, error) {
+	out := new(UpgradePath)
+	err := c.cc.Invoke(ctx, "/itemGrpcService/GetUpgradePath", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *itemGrpcServiceClient) CheckStorefront(ctx context.Context, in *CheckStorefrontReq, opts ...grpc.CallOption) (*CheckStorefrontRes, error) {
+	out := new(CheckStorefrontRes)
+	err := c.cc.Invoke(ctx, "/itemGrpcService/CheckStorefront", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ItemGrpcServiceServer is the server API for ItemGrpcService service.
 // All implementations must embed UnimplementedItemGrpcServiceServer
 // for forward compatibility
 type ItemGrpcServiceServer interface {
 	FindItemInIds(context.Context, *FindItemInIdsReq) (*FindItemInIdsRes, error)
+	FindOneLootBox(context.Context, *FindOneLootBoxReq) (*LootBox, error)
+	WatchCatalog(*WatchCatalogReq, ItemGrpcService_WatchCatalogServer) error
+	ListItems(context.Context, *ListItemsReq) (*ListItemsRes, error)
+	GetItemPrices(context.Context, *GetItemPricesReq) (*GetItemPricesRes, error)
+	GetRecipe(context.Context, *GetRecipeReq) (*Recipe, error)
+	GetUpgradePath(context.Context, *GetUpgradePathReq) (*UpgradePath, error)
+	CheckStorefront(context.Context, *CheckStorefrontReq) (*CheckStorefrontRes, error)
 	mustEmbedUnimplementedItemGrpcServiceServer()
 }
 
@@ -57,6 +157,27 @@ type UnimplementedItemGrpcServiceServer struct {
 func (UnimplementedItemGrpcServiceServer) FindItemInIds(context.Context, *FindItemInIdsReq) (*FindItemInIdsRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindItemInIds not implemented")
 }
+func (UnimplementedItemGrpcServiceServer) FindOneLootBox(context.Context, *FindOneLootBoxReq) (*LootBox, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindOneLootBox not implemented")
+}
+func (UnimplementedItemGrpcServiceServer) WatchCatalog(*WatchCatalogReq, ItemGrpcService_WatchCatalogServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchCatalog not implemented")
+}
+func (UnimplementedItemGrpcServiceServer) ListItems(context.Context, *ListItemsReq) (*ListItemsRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListItems not implemented")
+}
+func (UnimplementedItemGrpcServiceServer) GetItemPrices(context.Context, *GetItemPricesReq) (*GetItemPricesRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetItemPrices not implemented")
+}
+func (UnimplementedItemGrpcServiceServer) GetRecipe(context.Context, *GetRecipeReq) (*Recipe, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecipe not implemented")
+}
+func (UnimplementedItemGrpcServiceServer) GetUpgradePath(context.Context, *GetUpgradePathReq) (*UpgradePath, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUpgradePath not implemented")
+}
+func (UnimplementedItemGrpcServiceServer) CheckStorefront(context.Context, *CheckStorefrontReq) (*CheckStorefrontRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckStorefront not implemented")
+}
 func (UnimplementedItemGrpcServiceServer) mustEmbedUnimplementedItemGrpcServiceServer() {}
 
 // UnsafeItemGrpcServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -88,6 +209,135 @@ func _ItemGrpcService_FindItemInIds_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ItemGrpcService_FindOneLootBox_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindOneLootBoxReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemGrpcServiceServer).FindOneLootBox(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itemGrpcService/FindOneLootBox",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemGrpcServiceServer).FindOneLootBox(ctx, req.(*FindOneLootBoxReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemGrpcService_WatchCatalog_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchCatalogReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ItemGrpcServiceServer).WatchCatalog(m, &itemGrpcServiceWatchCatalogServer{stream})
+}
+
+type ItemGrpcService_WatchCatalogServer interface {
+	Send(*CatalogEvent) error
+	grpc.ServerStream
+}
+
+type itemGrpcServiceWatchCatalogServer struct {
+	grpc.ServerStream
+}
+
+func (x *itemGrpcServiceWatchCatalogServer) Send(m *CatalogEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ItemGrpcService_ListItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemGrpcServiceServer).ListItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itemGrpcService/ListItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemGrpcServiceServer).ListItems(ctx, req.(*ListItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemGrpcService_GetItemPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetItemPricesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemGrpcServiceServer).GetItemPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itemGrpcService/GetItemPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemGrpcServiceServer).GetItemPrices(ctx, req.(*GetItemPricesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemGrpcService_GetRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecipeReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemGrpcServiceServer).GetRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itemGrpcService/GetRecipe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemGrpcServiceServer).GetRecipe(ctx, req.(*GetRecipeReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemGrpcService_GetUpgradePath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUpgradePathReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemGrpcServiceServer).GetUpgradePath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itemGrpcService/GetUpgradePath",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemGrpcServiceServer).GetUpgradePath(ctx, req.(*GetUpgradePathReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ItemGrpcService_CheckStorefront_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckStorefrontReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ItemGrpcServiceServer).CheckStorefront(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/itemGrpcService/CheckStorefront",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ItemGrpcServiceServer).CheckStorefront(ctx, req.(*CheckStorefrontReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ItemGrpcService_ServiceDesc is the grpc.ServiceDesc for ItemGrpcService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -99,7 +349,37 @@ var ItemGrpcService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FindItemInIds",
 			Handler:    _ItemGrpcService_FindItemInIds_Handler,
 		},
+		{
+			MethodName: "FindOneLootBox",
+			Handler:    _ItemGrpcService_FindOneLootBox_Handler,
+		},
+		{
+			MethodName: "ListItems",
+			Handler:    _ItemGrpcService_ListItems_Handler,
+		},
+		{
+			MethodName: "GetItemPrices",
+			Handler:    _ItemGrpcService_GetItemPrices_Handler,
+		},
+		{
+			MethodName: "GetRecipe",
+			Handler:    _ItemGrpcService_GetRecipe_Handler,
+		},
+		{
+			MethodName: "GetUpgradePath",
+			Handler:    _ItemGrpcService_GetUpgradePath_Handler,
+		},
+		{
+			MethodName: "CheckStorefront",
+			Handler:    _ItemGrpcService_CheckStorefront_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchCatalog",
+			Handler:       _ItemGrpcService_WatchCatalog_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "modules/item/itemPb/itemPb.proto",
 }
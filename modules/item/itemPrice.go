@@ -0,0 +1,49 @@
+package item
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// EffectivePrice applies the schedule active at the given time, if any.
+func EffectivePrice(price float64, schedules []*PriceSchedule, at time.Time) float64 {
+	for _, v := range schedules {
+		if at.Before(v.StartAt) || !at.Before(v.EndAt) {
+			continue
+		}
+
+		switch v.Type {
+		case "percent":
+			return price * (100 - v.Value) / 100
+		case "absolute":
+			return v.Value
+		}
+	}
+	return price
+}
+
+// EffectivePriceExpr is EffectivePrice as an aggregation expression, so queries can filter and
+// sort on the price checkout charges
+func EffectivePriceExpr(at time.Time) bson.D {
+	active := bson.D{{"$filter", bson.D{
+		{"input", bson.D{{"$ifNull", bson.A{"$price_schedules", bson.A{}}}}},
+		{"as", "s"},
+		{"cond", bson.D{{"$and", bson.A{
+			bson.D{{"$lte", bson.A{"$$s.start_at", at}}},
+			bson.D{{"$gt", bson.A{"$$s.end_at", at}}},
+			bson.D{{"$in", bson.A{"$$s.type", bson.A{"percent", "absolute"}}}},
+		}}}},
+	}}}
+
+	return bson.D{{"$let", bson.D{
+		{"vars", bson.D{{"s", bson.D{{"$arrayElemAt", bson.A{active, 0}}}}}},
+		{"in", bson.D{{"$switch", bson.D{
+			{"branches", bson.A{
+				bson.D{{"case", bson.D{{"$eq", bson.A{"$$s.type", "percent"}}}}, {"then", bson.D{{"$divide", bson.A{bson.D{{"$multiply", bson.A{"$price", bson.D{{"$subtract", bson.A{100, "$$s.value"}}}}}}, 100}}}}},
+				bson.D{{"case", bson.D{{"$eq", bson.A{"$$s.type", "absolute"}}}}, {"then", "$$s.value"}},
+			}},
+			{"default", "$price"},
+		}}}},
+	}}}
+}
@@ -0,0 +1,49 @@
+package item
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type (
+	testEffectivePrice struct {
+		price     float64
+		schedules []*PriceSchedule
+		at        time.Time
+		expected  float64
+	}
+)
+
+func TestEffectivePrice(t *testing.T) {
+	startAt := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	endAt := startAt.Add(24 * time.Hour)
+
+	percent := &PriceSchedule{Type: "percent", Value: 25, StartAt: startAt, EndAt: endAt}
+	absolute := &PriceSchedule{Type: "absolute", Value: 40, StartAt: startAt, EndAt: endAt}
+	expired := &PriceSchedule{Type: "percent", Value: 50, StartAt: startAt.Add(-48 * time.Hour), EndAt: startAt}
+	unknown := &PriceSchedule{Type: "bogus", Value: 1, StartAt: startAt, EndAt: endAt}
+
+	tests := []testEffectivePrice{
+		{price: 100, schedules: nil, at: startAt, expected: 100},
+		{price: 100, schedules: []*PriceSchedule{percent}, at: startAt.Add(time.Hour), expected: 75},
+		{price: 100, schedules: []*PriceSchedule{absolute}, at: startAt.Add(time.Hour), expected: 40},
+		// The window includes its start and excludes its end
+		{price: 100, schedules: []*PriceSchedule{absolute}, at: startAt, expected: 40},
+		{price: 100, schedules: []*PriceSchedule{absolute}, at: endAt, expected: 100},
+		{price: 100, schedules: []*PriceSchedule{percent}, at: startAt.Add(-time.Second), expected: 100},
+		// The first schedule active at the time wins, unknown types are skipped
+		{price: 100, schedules: []*PriceSchedule{expired, absolute, percent}, at: startAt.Add(time.Hour), expected: 40},
+		{price: 100, schedules: []*PriceSchedule{unknown, percent}, at: startAt, expected: 75},
+	}
+
+	for i, test := range tests {
+		fmt.Printf("case -> %d\n", i+1)
+
+		result := EffectivePrice(test.price, test.schedules, test.at)
+
+		assert.Equal(t, test.expected, result)
+	}
+}
@@ -2,11 +2,14 @@ package itemRepository
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"log"
 	"time"
 
+	"github.com/Applessr/hello-sekai-shop-tutorial/config"
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/item"
+	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/queue"
 	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/utils"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -19,10 +22,31 @@ type (
 		InsertOneItem(pctx context.Context, req *item.Item) (primitive.ObjectID, error)
 		IsUniqueItem(pctx context.Context, title string) bool
 		FindOneItem(pctx context.Context, itemId string) (*item.Item, error)
+		FindOneItemByTitle(pctx context.Context, title string) (*item.Item, error)
+		FindAllItems(pctx context.Context) ([]*item.Item, error)
 		FindManyItems(pctx context.Context, filter primitive.D, option []*options.FindOptions) ([]*item.ItemShowCase, error)
+		FindManyItemsByPrice(pctx context.Context, filter, after primitive.D, order int, limit int64) ([]*item.ItemShowCase, error)
 		CountItems(pctx context.Context, filter primitive.D) (int64, error)
 		UpdateOneItem(pctx context.Context, itemId string, req primitive.M) error
 		EnableOrDisableItem(pctx context.Context, itemId string, isActive bool) error
+		FindItemFacets(pctx context.Context, filter primitive.D) (*item.ItemFacets, error)
+		PublishItemEvent(pctx context.Context, cfg *config.Config, req *item.ItemEvent) error
+		ReplaceOneItem(pctx context.Context, itemId string, req *item.Item) error
+		InsertOneItemRevision(pctx context.Context, req *item.ItemRevision) error
+		FindItemRevisions(pctx context.Context, itemId string) ([]*item.ItemRevision, error)
+		FindOneItemRevision(pctx context.Context, itemId, revisionId string) (*item.ItemRevision, error)
+		PushPriceSchedule(pctx context.Context, itemId string, req *item.PriceSchedule) error
+		PullPriceSchedule(pctx context.Context, itemId, scheduleId string) error
+		FindDuePriceSchedules(pctx context.Context, now time.Time) ([]*item.Item, error)
+		AnnouncePriceSchedule(pctx context.Context, itemId, scheduleId, field string, announced bool) (*item.Item, error)
+		InsertOneRecipe(pctx context.Context, req *item.Recipe) (primitive.ObjectID, error)
+		FindOneRecipe(pctx context.Context, recipeId string) (*item.Recipe, error)
+		FindRecipes(pctx context.Context, filter primitive.D) ([]*item.Recipe, error)
+		EnableOrDisableRecipe(pctx context.Context, recipeId string, isActive bool) error
+		InsertOneStorefrontRule(pctx context.Context, req *item.StorefrontRule) (primitive.ObjectID, error)
+		FindOneStorefrontRule(pctx context.Context, ruleId string) (*item.StorefrontRule, error)
+		FindStorefrontRules(pctx context.Context, filter primitive.D) ([]*item.StorefrontRule, error)
+		EnableOrDisableStorefrontRule(pctx context.Context, ruleId string, isActive bool) error
 	}
 
 	itemRepository struct {
@@ -87,6 +111,44 @@ func (r *itemRepository) FindOneItem(pctx context.Context, itemId string) (*item
 	return result, nil
 }
 
+func (r *itemRepository) FindOneItemByTitle(pctx context.Context, title string) (*item.Item, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.itemDbConnect(ctx)
+	col := db.Collection("item")
+
+	result := new(item.Item)
+	if err := col.FindOne(ctx, bson.M{"title": title}).Decode(result); err != nil {
+		log.Printf("Error: FindOneItemByTitle: %s", err.Error())
+		return nil, errors.New("error: item not found")
+	}
+
+	return result, nil
+}
+
+func (r *itemRepository) FindAllItems(pctx context.Context) ([]*item.Item, error) {
+	ctx, cancel := context.WithTimeout(pctx, 30*time.Second)
+	defer cancel()
+
+	db := r.itemDbConnect(ctx)
+	col := db.Collection("item")
+
+	cursors, err := col.Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{"_id", 1}}))
+	if err != nil {
+		log.Printf("Error: FindAllItems: %s", err.Error())
+		return nil, errors.New("error: find all items failed")
+	}
+
+	results := make([]*item.Item, 0)
+	if err := cursors.All(ctx, &results); err != nil {
+		log.Printf("Error: FindAllItems: %s", err.Error())
+		return nil, errors.New("error: find all items failed")
+	}
+
+	return results, nil
+}
+
 func (r *itemRepository) FindManyItems(pctx context.Context, filter primitive.D, option []*options.FindOptions) ([]*item.ItemShowCase, error) {
 	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
 	defer cancel()
@@ -107,18 +169,82 @@ func (r *itemRepository) FindManyItems(pctx context.Context, filter primitive.D,
 			log.Printf("Error: FindManyItems: %s", err.Error())
 			return make([]*item.ItemShowCase, 0), errors.New("error: find many items failed")
 		}
-		results = append(results, &item.ItemShowCase{
-			ItemId:   "item:" + result.Id.Hex(),
-			Title:    result.Title,
-			Price:    result.Price,
-			Damage:   result.Damage,
-			ImageUrl: result.ImageUrl,
-		})
+
+		results = append(results, itemShowCaseOf(result))
+	}
+
+	return results, nil
+}
+
+// FindManyItemsByPrice sorts on the effective price, after is matched against effective_price
+// and _id to continue from a previous page
+func (r *itemRepository) FindManyItemsByPrice(pctx context.Context, filter, after primitive.D, order int, limit int64) ([]*item.ItemShowCase, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.itemDbConnect(ctx)
+	col := db.Collection("item")
+
+	pipeline := mongo.Pipeline{
+		{{"$match", filter}},
+		{{"$addFields", bson.D{{"effective_price", item.EffectivePriceExpr(utils.LocalTime())}}}},
+	}
+	if len(after) > 0 {
+		pipeline = append(pipeline, bson.D{{"$match", after}})
+	}
+	pipeline = append(pipeline, bson.D{{"$sort", bson.D{{"effective_price", order}, {"_id", order}}}})
+	if limit > 0 {
+		pipeline = append(pipeline, bson.D{{"$limit", limit}})
+	}
+
+	cursors, err := col.Aggregate(ctx, pipeline)
+	if err != nil {
+		log.Printf("Error: FindManyItemsByPrice: %s", err.Error())
+		return nil, errors.New("error: find many items failed")
+	}
+
+	results := make([]*item.ItemShowCase, 0)
+	for cursors.Next(ctx) {
+		result := new(item.Item)
+		if err := cursors.Decode(result); err != nil {
+			log.Printf("Error: FindManyItemsByPrice: %s", err.Error())
+			return make([]*item.ItemShowCase, 0), errors.New("error: find many items failed")
+		}
+
+		results = append(results, itemShowCaseOf(result))
 	}
 
 	return results, nil
 }
 
+func itemShowCaseOf(result *item.Item) *item.ItemShowCase {
+	showCase := &item.ItemShowCase{
+		ItemId:          "item:" + result.Id.Hex(),
+		Title:           result.Title,
+		Description:     result.Description,
+		Price:           item.EffectivePrice(result.Price, result.PriceSchedules, utils.LocalTime()),
+		Damage:          result.Damage,
+		ImageUrl:        result.ImageUrl,
+		BundleItems:     result.BundleItems,
+		Category:        result.Category,
+		Rarity:          result.Rarity,
+		Tags:            result.Tags,
+		Attributes:      result.Attributes,
+		Thumbnails:      result.Thumbnails,
+		Stackable:       result.Stackable,
+		MaxStack:        result.MaxStack,
+		InventorySlots:  result.InventorySlots,
+		EquipType:       result.EquipType,
+		DurationSeconds: result.DurationSeconds,
+		Version:         result.Version,
+	}
+	if showCase.Price != result.Price {
+		showCase.OriginalPrice = result.Price
+	}
+
+	return showCase
+}
+
 func (r *itemRepository) CountItems(pctx context.Context, filter primitive.D) (int64, error) {
 	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
 	defer cancel()
@@ -135,6 +261,44 @@ func (r *itemRepository) CountItems(pctx context.Context, filter primitive.D) (i
 	return count, nil
 }
 
+func (r *itemRepository) FindItemFacets(pctx context.Context, filter primitive.D) (*item.ItemFacets, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.itemDbConnect(ctx)
+	col := db.Collection("item")
+
+	countBy := func(field string) bson.A {
+		return bson.A{
+			bson.M{"$match": bson.M{field: bson.M{"$nin": bson.A{nil, ""}}}},
+			bson.M{"$group": bson.M{"_id": "$" + field, "count": bson.M{"$sum": 1}}},
+			bson.M{"$sort": bson.D{{"count", -1}, {"_id", 1}}},
+			bson.M{"$limit": 50},
+		}
+	}
+
+	cursors, err := col.Aggregate(ctx, bson.A{
+		bson.M{"$match": filter},
+		bson.M{"$facet": bson.M{
+			"categories": countBy("category"),
+			"rarities":   countBy("rarity"),
+			"tags":       append(bson.A{bson.M{"$unwind": "$tags"}}, countBy("tags")...),
+		}},
+	})
+	if err != nil {
+		log.Printf("Error: FindItemFacets failed: %s", err.Error())
+		return nil, errors.New("error: find item facets failed")
+	}
+
+	results := make([]*item.ItemFacets, 0)
+	if err := cursors.All(ctx, &results); err != nil || len(results) == 0 {
+		log.Printf("Error: FindItemFacets failed: %v", err)
+		return nil, errors.New("error: find item facets failed")
+	}
+
+	return results[0], nil
+}
+
 func (r *itemRepository) UpdateOneItem(pctx context.Context, itemId string, req primitive.M) error {
 	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
 	defer cancel()
@@ -142,7 +306,7 @@ func (r *itemRepository) UpdateOneItem(pctx context.Context, itemId string, req
 	db := r.itemDbConnect(ctx)
 	col := db.Collection("item")
 
-	result, err := col.UpdateOne(ctx, bson.M{"_id": utils.ConvertToObjectId(itemId)}, bson.M{"$set": req})
+	result, err := col.UpdateOne(ctx, bson.M{"_id": utils.ConvertToObjectId(itemId)}, bson.M{"$set": req, "$inc": bson.M{"version": 1}})
 	if err != nil {
 		log.Printf("Error: UpdateOneItem failed: %s", err.Error())
 		return errors.New("error: update one item failed")
@@ -168,3 +332,343 @@ func (r *itemRepository) EnableOrDisableItem(pctx context.Context, itemId string
 
 	return nil
 }
+
+func (r *itemRepository) PushPriceSchedule(pctx context.Context, itemId string, req *item.PriceSchedule) error {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.itemDbConnect(ctx)
+	col := db.Collection("item")
+
+	result, err := col.UpdateOne(
+		ctx,
+		bson.M{"_id": utils.ConvertToObjectId(itemId)},
+		bson.M{"$push": bson.M{"price_schedules": req}, "$set": bson.M{"updated_at": utils.LocalTime()}, "$inc": bson.M{"version": 1}},
+	)
+	if err != nil {
+		log.Printf("Error: PushPriceSchedule failed: %s", err.Error())
+		return errors.New("error: create price schedule failed")
+	}
+	log.Printf("PushPriceSchedule result: %v", result.ModifiedCount)
+
+	return nil
+}
+
+// FindDuePriceSchedules returns the items with a price schedule that started or ended without being announced
+func (r *itemRepository) FindDuePriceSchedules(pctx context.Context, now time.Time) ([]*item.Item, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.itemDbConnect(ctx)
+	col := db.Collection("item")
+
+	cursors, err := col.Find(ctx, bson.M{"price_schedules": bson.M{"$elemMatch": bson.M{"$or": bson.A{
+		bson.M{"start_at": bson.M{"$lte": now}, "start_announced": bson.M{"$ne": true}},
+		bson.M{"end_at": bson.M{"$lte": now}, "end_announced": bson.M{"$ne": true}},
+	}}}})
+	if err != nil {
+		log.Printf("Error: FindDuePriceSchedules failed: %s", err.Error())
+		return nil, errors.New("error: find due price schedules failed")
+	}
+
+	results := make([]*item.Item, 0)
+	if err := cursors.All(ctx, &results); err != nil {
+		log.Printf("Error: FindDuePriceSchedules failed: %s", err.Error())
+		return nil, errors.New("error: find due price schedules failed")
+	}
+
+	return results, nil
+}
+
+// AnnouncePriceSchedule flips start_announced or end_announced and bumps the version so consumers
+// take the new effective price, nil means another instance already did it
+func (r *itemRepository) AnnouncePriceSchedule(pctx context.Context, itemId, scheduleId, field string, announced bool) (*item.Item, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.itemDbConnect(ctx)
+	col := db.Collection("item")
+
+	result := new(item.Item)
+	if err := col.FindOneAndUpdate(
+		ctx,
+		bson.M{
+			"_id":             utils.ConvertToObjectId(itemId),
+			"price_schedules": bson.M{"$elemMatch": bson.M{"schedule_id": scheduleId, field: bson.M{"$ne": announced}}},
+		},
+		bson.M{"$set": bson.M{"price_schedules.$." + field: announced, "updated_at": utils.LocalTime()}, "$inc": bson.M{"version": 1}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(result); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		log.Printf("Error: AnnouncePriceSchedule failed: %s", err.Error())
+		return nil, errors.New("error: announce price schedule failed")
+	}
+
+	return result, nil
+}
+
+func (r *itemRepository) PullPriceSchedule(pctx context.Context, itemId, scheduleId string) error {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.itemDbConnect(ctx)
+	col := db.Collection("item")
+
+	result, err := col.UpdateOne(
+		ctx,
+		bson.M{"_id": utils.ConvertToObjectId(itemId)},
+		bson.M{"$pull": bson.M{"price_schedules": bson.M{"schedule_id": scheduleId}}, "$set": bson.M{"updated_at": utils.LocalTime()}, "$inc": bson.M{"version": 1}},
+	)
+	if err != nil {
+		log.Printf("Error: PullPriceSchedule failed: %s", err.Error())
+		return errors.New("error: delete price schedule failed")
+	}
+
+	if result.ModifiedCount == 0 {
+		return errors.New("error: price schedule not found")
+	}
+
+	return nil
+}
+
+func (r *itemRepository) ReplaceOneItem(pctx context.Context, itemId string, req *item.Item) error {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.itemDbConnect(ctx)
+	col := db.Collection("item")
+
+	req.Id = utils.ConvertToObjectId(itemId)
+	result, err := col.ReplaceOne(ctx, bson.M{"_id": req.Id}, req)
+	if err != nil {
+		log.Printf("Error: ReplaceOneItem failed: %s", err.Error())
+		return errors.New("error: replace one item failed")
+	}
+	if result.MatchedCount == 0 {
+		return errors.New("error: item not found")
+	}
+
+	return nil
+}
+
+func (r *itemRepository) InsertOneItemRevision(pctx context.Context, req *item.ItemRevision) error {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.itemDbConnect(ctx)
+	col := db.Collection("item_revisions")
+
+	if _, err := col.InsertOne(ctx, req); err != nil {
+		log.Printf("Error: InsertOneItemRevision failed: %s", err.Error())
+		return errors.New("error: insert item revision failed")
+	}
+
+	return nil
+}
+
+func (r *itemRepository) FindItemRevisions(pctx context.Context, itemId string) ([]*item.ItemRevision, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.itemDbConnect(ctx)
+	col := db.Collection("item_revisions")
+
+	cursors, err := col.Find(
+		ctx,
+		bson.M{"item_id": itemId},
+		options.Find().SetSort(bson.D{{"created_at", -1}, {"_id", -1}}).SetLimit(100).SetProjection(bson.M{"snapshot": 0}),
+	)
+	if err != nil {
+		log.Printf("Error: FindItemRevisions failed: %s", err.Error())
+		return nil, errors.New("error: find item revisions failed")
+	}
+
+	results := make([]*item.ItemRevision, 0)
+	if err := cursors.All(ctx, &results); err != nil {
+		log.Printf("Error: FindItemRevisions failed: %s", err.Error())
+		return nil, errors.New("error: find item revisions failed")
+	}
+
+	return results, nil
+}
+
+func (r *itemRepository) FindOneItemRevision(pctx context.Context, itemId, revisionId string) (*item.ItemRevision, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.itemDbConnect(ctx)
+	col := db.Collection("item_revisions")
+
+	result := new(item.ItemRevision)
+	if err := col.FindOne(ctx, bson.M{"_id": utils.ConvertToObjectId(revisionId), "item_id": itemId}).Decode(result); err != nil {
+		log.Printf("Error: FindOneItemRevision failed: %s", err.Error())
+		return nil, errors.New("error: item revision not found")
+	}
+
+	return result, nil
+}
+
+func (r *itemRepository) PublishItemEvent(pctx context.Context, cfg *config.Config, req *item.ItemEvent) error {
+	reqInBytes, err := json.Marshal(req)
+	if err != nil {
+		log.Printf("Error: PublishItemEvent failed: %s", err.Error())
+		return errors.New("error: publish item event failed")
+	}
+
+	// Keyed by item id so every event of one item lands on the same partition in order
+	if err := queue.PushMessageWithKeyToQueue(
+		[]string{cfg.Kafka.Url},
+		cfg.Kafka.ApiKey,
+		cfg.Kafka.Secret,
+		"item",
+		req.ItemId,
+		reqInBytes,
+	); err != nil {
+		log.Printf("Error: PublishItemEvent failed: %s", err.Error())
+		return errors.New("error: publish item event failed")
+	}
+
+	return nil
+}
+
+func (r *itemRepository) InsertOneRecipe(pctx context.Context, req *item.Recipe) (primitive.ObjectID, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.itemDbConnect(ctx)
+	col := db.Collection("recipes")
+
+	recipeId, err := col.InsertOne(ctx, req)
+	if err != nil {
+		log.Printf("Error: InsertOneRecipe: %s", err.Error())
+		return primitive.ObjectID{}, errors.New("error: insert one recipe failed")
+	}
+	return recipeId.InsertedID.(primitive.ObjectID), nil
+}
+
+func (r *itemRepository) FindOneRecipe(pctx context.Context, recipeId string) (*item.Recipe, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.itemDbConnect(ctx)
+	col := db.Collection("recipes")
+
+	result := new(item.Recipe)
+	if err := col.FindOne(ctx, bson.M{"_id": utils.ConvertToObjectId(recipeId)}).Decode(result); err != nil {
+		log.Printf("Error: FindOneRecipe: %s", err.Error())
+		return nil, errors.New("error: recipe not found")
+	}
+
+	return result, nil
+}
+
+func (r *itemRepository) FindRecipes(pctx context.Context, filter primitive.D) ([]*item.Recipe, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.itemDbConnect(ctx)
+	col := db.Collection("recipes")
+
+	cursors, err := col.Find(ctx, filter, options.Find().SetSort(bson.D{{"title", 1}}))
+	if err != nil {
+		log.Printf("Error: FindRecipes: %s", err.Error())
+		return nil, errors.New("error: find recipes failed")
+	}
+
+	results := make([]*item.Recipe, 0)
+	if err := cursors.All(ctx, &results); err != nil {
+		log.Printf("Error: FindRecipes: %s", err.Error())
+		return nil, errors.New("error: find recipes failed")
+	}
+
+	return results, nil
+}
+
+func (r *itemRepository) EnableOrDisableRecipe(pctx context.Context, recipeId string, isActive bool) error {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.itemDbConnect(ctx)
+	col := db.Collection("recipes")
+
+	result, err := col.UpdateOne(ctx, bson.M{"_id": utils.ConvertToObjectId(recipeId)}, bson.M{"$set": bson.M{"usage_status": isActive, "updated_at": utils.LocalTime()}})
+	if err != nil {
+		log.Printf("Error: EnableOrDisableRecipe failed: %s", err.Error())
+		return errors.New("error: enable or disable recipe failed")
+	}
+	log.Printf("EnableOrDisableRecipe result: %v", result.ModifiedCount)
+
+	return nil
+}
+
+func (r *itemRepository) InsertOneStorefrontRule(pctx context.Context, req *item.StorefrontRule) (primitive.ObjectID, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.itemDbConnect(ctx)
+	col := db.Collection("storefront_rules")
+
+	ruleId, err := col.InsertOne(ctx, req)
+	if err != nil {
+		log.Printf("Error: InsertOneStorefrontRule: %s", err.Error())
+		return primitive.ObjectID{}, errors.New("error: insert one storefront rule failed")
+	}
+	return ruleId.InsertedID.(primitive.ObjectID), nil
+}
+
+func (r *itemRepository) FindOneStorefrontRule(pctx context.Context, ruleId string) (*item.StorefrontRule, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.itemDbConnect(ctx)
+	col := db.Collection("storefront_rules")
+
+	result := new(item.StorefrontRule)
+	if err := col.FindOne(ctx, bson.M{"_id": utils.ConvertToObjectId(ruleId)}).Decode(result); err != nil {
+		log.Printf("Error: FindOneStorefrontRule: %s", err.Error())
+		return nil, errors.New("error: storefront rule not found")
+	}
+
+	return result, nil
+}
+
+func (r *itemRepository) FindStorefrontRules(pctx context.Context, filter primitive.D) ([]*item.StorefrontRule, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.itemDbConnect(ctx)
+	col := db.Collection("storefront_rules")
+
+	cursors, err := col.Find(ctx, filter, options.Find().SetSort(bson.D{{"_id", 1}}))
+	if err != nil {
+		log.Printf("Error: FindStorefrontRules: %s", err.Error())
+		return nil, errors.New("error: find storefront rules failed")
+	}
+
+	results := make([]*item.StorefrontRule, 0)
+	if err := cursors.All(ctx, &results); err != nil {
+		log.Printf("Error: FindStorefrontRules: %s", err.Error())
+		return nil, errors.New("error: find storefront rules failed")
+	}
+
+	return results, nil
+}
+
+func (r *itemRepository) EnableOrDisableStorefrontRule(pctx context.Context, ruleId string, isActive bool) error {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.itemDbConnect(ctx)
+	col := db.Collection("storefront_rules")
+
+	result, err := col.UpdateOne(ctx, bson.M{"_id": utils.ConvertToObjectId(ruleId)}, bson.M{"$set": bson.M{"usage_status": isActive, "updated_at": utils.LocalTime()}})
+	if err != nil {
+		log.Printf("Error: EnableOrDisableStorefrontRule failed: %s", err.Error())
+		return errors.New("error: enable or disable storefront rule failed")
+	}
+	log.Printf("EnableOrDisableStorefrontRule result: %v", result.ModifiedCount)
+
+	return nil
+}
@@ -0,0 +1,15 @@
+package item
+
+// DefaultMaxStack is used when a stackable item is saved without a max stack
+const DefaultMaxStack = 99
+
+// StackLimit normalises the stack settings of an item, 0 means the item does not stack
+func StackLimit(stackable bool, maxStack int) int {
+	if !stackable {
+		return 0
+	}
+	if maxStack <= 0 {
+		return DefaultMaxStack
+	}
+	return maxStack
+}
@@ -0,0 +1,17 @@
+package item
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStackLimit(t *testing.T) {
+	// Items that don't stack have no limit, whatever max stack they were saved with
+	assert.Equal(t, 0, StackLimit(false, 10))
+	assert.Equal(t, 0, StackLimit(false, 0))
+
+	assert.Equal(t, 10, StackLimit(true, 10))
+	assert.Equal(t, DefaultMaxStack, StackLimit(true, 0))
+	assert.Equal(t, DefaultMaxStack, StackLimit(true, -5))
+}
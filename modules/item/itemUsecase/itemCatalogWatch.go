@@ -0,0 +1,293 @@
+package itemUsecase
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Applessr/hello-sekai-shop-tutorial/config"
+	"github.com/Applessr/hello-sekai-shop-tutorial/modules/item"
+	itemPb "github.com/Applessr/hello-sekai-shop-tutorial/modules/item/itemPb"
+	"github.com/Applessr/hello-sekai-shop-tutorial/modules/models"
+	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/utils"
+	"github.com/go-playground/validator/v10"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// catalogHub fans the events read from the item topic out to WatchCatalog streams,
+// so every instance delivers the changes made by the others
+type catalogHub struct {
+	mu          sync.Mutex
+	subscribers map[chan *itemPb.CatalogEvent]struct{}
+}
+
+func newCatalogHub() *catalogHub {
+	return &catalogHub{subscribers: make(map[chan *itemPb.CatalogEvent]struct{})}
+}
+
+func (h *catalogHub) subscribe() chan *itemPb.CatalogEvent {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	ch := make(chan *itemPb.CatalogEvent, 64)
+	h.subscribers[ch] = struct{}{}
+	return ch
+}
+
+func (h *catalogHub) unsubscribe(ch chan *itemPb.CatalogEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if _, ok := h.subscribers[ch]; ok {
+		delete(h.subscribers, ch)
+		close(ch)
+	}
+}
+
+// broadcast never blocks, a subscriber that falls behind is dropped and has to resync
+func (h *catalogHub) broadcast(event *itemPb.CatalogEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for ch := range h.subscribers {
+		select {
+		case ch <- event:
+		default:
+			delete(h.subscribers, ch)
+			close(ch)
+		}
+	}
+}
+
+func toPbItem(v *item.Item) *itemPb.Item {
+	price := item.EffectivePrice(v.Price, v.PriceSchedules, utils.LocalTime())
+	result := &itemPb.Item{
+		Id:              "item:" + v.Id.Hex(),
+		Title:           v.Title,
+		Price:           price,
+		ImageUrl:        v.ImageUrl,
+		Damage:          int32(v.Damage),
+		BundleItems:     v.BundleItems,
+		Category:        v.Category,
+		Rarity:          v.Rarity,
+		Tags:            v.Tags,
+		Stackable:       v.Stackable,
+		MaxStack:        int32(v.MaxStack),
+		InventorySlots:  int32(v.InventorySlots),
+		EquipType:       v.EquipType,
+		DurationSeconds: v.DurationSeconds,
+		Version:         v.Version,
+	}
+	if price != v.Price {
+		result.OriginalPrice = v.Price
+	}
+	return result
+}
+
+func toCatalogEvent(event *item.ItemEvent) *itemPb.CatalogEvent {
+	return &itemPb.CatalogEvent{
+		Type:       event.Type,
+		Version:    event.Version,
+		IsActive:   event.Item.UsageStatus,
+		Item:       toPbItem(event.Item),
+		OccurredAt: event.OccurredAt.Format(time.RFC3339),
+	}
+}
+
+func (u *itemUsecase) BroadcastItemEvent(event *item.ItemEvent) {
+	if event.Item == nil {
+		return
+	}
+	u.catalogHub.broadcast(toCatalogEvent(event))
+}
+
+// AnnouncePriceSchedules publishes an item.price_changed event when a price schedule starts or ends,
+// the effective price moves without anyone editing the item so nothing else would tell the consumers
+func (u *itemUsecase) AnnouncePriceSchedules(pctx context.Context, cfg *config.Config) {
+	now := utils.LocalTime()
+
+	results, err := u.itemRepository.FindDuePriceSchedules(pctx, now)
+	if err != nil {
+		return
+	}
+
+	for _, result := range results {
+		itemId := result.Id.Hex()
+		for _, v := range result.PriceSchedules {
+			if !v.StartAt.After(now) && !v.StartAnnounced {
+				u.announcePriceSchedule(pctx, cfg, itemId, v.ScheduleId, "start_announced")
+			}
+			if !v.EndAt.After(now) && !v.EndAnnounced {
+				u.announcePriceSchedule(pctx, cfg, itemId, v.ScheduleId, "end_announced")
+			}
+		}
+	}
+}
+
+func (u *itemUsecase) announcePriceSchedule(pctx context.Context, cfg *config.Config, itemId, scheduleId, field string) {
+	after, err := u.itemRepository.AnnouncePriceSchedule(pctx, itemId, scheduleId, field, true)
+	if err != nil || after == nil {
+		return
+	}
+
+	if err := u.itemRepository.PublishItemEvent(pctx, cfg, &item.ItemEvent{
+		EventId:    primitive.NewObjectID().Hex(),
+		Type:       "item.price_changed",
+		ItemId:     "item:" + itemId,
+		Version:    after.Version,
+		Item:       after,
+		OccurredAt: utils.LocalTime(),
+	}); err != nil {
+		// Cleared again so the next sweep retries it
+		log.Printf("Error: AnnouncePriceSchedules failed: item %s schedule %s: %s", itemId, scheduleId, err.Error())
+		u.itemRepository.AnnouncePriceSchedule(pctx, itemId, scheduleId, field, false)
+	}
+}
+
+func (u *itemUsecase) WatchCatalog(pctx context.Context, req *itemPb.WatchCatalogReq, send func(*itemPb.CatalogEvent) error) error {
+	// Subscribe before reading the snapshot so no change falls in between, consumers dedupe by version
+	ch := u.catalogHub.subscribe()
+	defer u.catalogHub.unsubscribe(ch)
+
+	if req.IncludeSnapshot {
+		results, err := u.itemRepository.FindAllItems(pctx)
+		if err != nil {
+			return err
+		}
+		for _, result := range results {
+			if !result.UsageStatus {
+				continue
+			}
+			if err := send(&itemPb.CatalogEvent{
+				Type:       "item.snapshot",
+				Version:    result.Version,
+				IsActive:   true,
+				Item:       toPbItem(result),
+				OccurredAt: utils.LocalTime().Format(time.RFC3339),
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	for {
+		select {
+		case <-pctx.Done():
+			return nil
+		case event, ok := <-ch:
+			if !ok {
+				log.Printf("Error: WatchCatalog failed: subscriber fell behind")
+				return errors.New("error: watch catalog fell behind, resubscribe with a snapshot")
+			}
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (u *itemUsecase) ListItems(pctx context.Context, req *itemPb.ListItemsReq) (*itemPb.ListItemsRes, error) {
+	searchReq := &item.ItemSearchReq{
+		Title:     req.Title,
+		Q:         req.Q,
+		Category:  req.Category,
+		Rarity:    req.Rarity,
+		Tags:      req.Tags,
+		MinPrice:  req.MinPrice,
+		MaxPrice:  req.MaxPrice,
+		MinDamage: int(req.MinDamage),
+		MaxDamage: int(req.MaxDamage),
+		Sort:      req.Sort,
+		PaginateReq: models.PaginateReq{
+			Start: req.Start,
+			Limit: int(req.Limit),
+		},
+	}
+	if err := validator.New().Struct(searchReq); err != nil {
+		return nil, err
+	}
+
+	res, err := u.FindManyItem(pctx, "", searchReq)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]*itemPb.Item, 0)
+	for _, v := range res.Data.([]*item.ItemShowCase) {
+		items = append(items, &itemPb.Item{
+			Id:              v.ItemId,
+			Title:           v.Title,
+			Price:           v.Price,
+			ImageUrl:        v.ImageUrl,
+			Damage:          int32(v.Damage),
+			BundleItems:     v.BundleItems,
+			OriginalPrice:   v.OriginalPrice,
+			Category:        v.Category,
+			Rarity:          v.Rarity,
+			Tags:            v.Tags,
+			Stackable:       v.Stackable,
+			MaxStack:        int32(v.MaxStack),
+			InventorySlots:  int32(v.InventorySlots),
+			EquipType:       v.EquipType,
+			DurationSeconds: v.DurationSeconds,
+			Version:         v.Version,
+		})
+	}
+
+	return &itemPb.ListItemsRes{
+		Items: items,
+		Total: res.Total,
+		Next:  res.Next.Start,
+	}, nil
+}
+
+// GetItemPrices returns effective prices with a token per item and one over the whole request,
+// the token changes when the item is edited or a scheduled price starts or ends
+func (u *itemUsecase) GetItemPrices(pctx context.Context, req *itemPb.GetItemPricesReq) (*itemPb.GetItemPricesRes, error) {
+	objectIds := make([]primitive.ObjectID, 0)
+	for _, itemId := range req.Ids {
+		objectIds = append(objectIds, utils.ConvertToObjectId(strings.TrimPrefix(itemId, "item:")))
+	}
+
+	results, err := u.itemRepository.FindManyItems(pctx, bson.D{
+		{"_id", bson.D{{"$in", objectIds}}},
+		{"usage_status", true},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	prices := make([]*itemPb.ItemPrice, 0)
+	for _, result := range results {
+		prices = append(prices, &itemPb.ItemPrice{
+			Id:            result.ItemId,
+			Price:         result.Price,
+			OriginalPrice: result.OriginalPrice,
+			PriceVersion:  priceVersion(fmt.Sprintf("%s|%d|%v", result.ItemId, result.Version, result.Price)),
+		})
+	}
+	sort.Slice(prices, func(i, j int) bool { return prices[i].Id < prices[j].Id })
+
+	tokens := make([]string, 0)
+	for _, p := range prices {
+		tokens = append(tokens, p.PriceVersion)
+	}
+
+	return &itemPb.GetItemPricesRes{
+		Prices:       prices,
+		PriceVersion: priceVersion(strings.Join(tokens, ",")),
+	}, nil
+}
+
+func priceVersion(v string) string {
+	sum := sha256.Sum256([]byte(v))
+	return hex.EncodeToString(sum[:8])
+}
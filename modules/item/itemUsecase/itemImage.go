@@ -0,0 +1,140 @@
+package itemUsecase
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Applessr/hello-sekai-shop-tutorial/config"
+	"github.com/Applessr/hello-sekai-shop-tutorial/modules/item"
+	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"golang.org/x/image/draw"
+)
+
+const (
+	MaxImageSize      = 5 << 20
+	maxImageDimension = 4096
+)
+
+var thumbnailWidths = []int{64, 128, 256}
+
+func (u *itemUsecase) UploadImage(pctx context.Context, cfg *config.Config, editorId, itemId string, data []byte) (*item.ItemImageRes, error) {
+	if len(data) == 0 || len(data) > MaxImageSize {
+		return nil, fmt.Errorf("error: image must be between 1 byte and %d bytes", MaxImageSize)
+	}
+
+	var before *item.Item
+	if itemId != "" {
+		result, err := u.itemRepository.FindOneItem(pctx, itemId)
+		if err != nil {
+			return nil, errors.New("error: find one item not found")
+		}
+		before = result
+	}
+
+	// Content type is sniffed from the bytes, the client header is not trusted
+	contentType := http.DetectContentType(data)
+	ext := ""
+	switch contentType {
+	case "image/png":
+		ext = "png"
+	case "image/jpeg":
+		ext = "jpg"
+	default:
+		return nil, errors.New("error: image must be png or jpeg")
+	}
+
+	imgCfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.New("error: invalid image")
+	}
+	if imgCfg.Width > maxImageDimension || imgCfg.Height > maxImageDimension {
+		return nil, fmt.Errorf("error: image must be at most %dx%d", maxImageDimension, maxImageDimension)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.New("error: invalid image")
+	}
+
+	sum := sha256.Sum256(data)
+	prefix := "items/" + hex.EncodeToString(sum[:16])
+	baseUrl := strings.TrimSuffix(cfg.Blob.PublicUrl, "/")
+
+	originalKey := fmt.Sprintf("%s/original.%s", prefix, ext)
+	if err := u.blobStore.Put(pctx, originalKey, contentType, data); err != nil {
+		return nil, err
+	}
+
+	res := &item.ItemImageRes{
+		ImageUrl:   baseUrl + "/" + originalKey,
+		Thumbnails: make(map[string]string),
+	}
+	for _, width := range thumbnailWidths {
+		buf := new(bytes.Buffer)
+		if err := encodeImage(buf, ext, thumbnail(img, width)); err != nil {
+			log.Printf("Error: UploadImage failed: thumbnail %d: %s", width, err.Error())
+			return nil, errors.New("error: generate thumbnail failed")
+		}
+
+		key := fmt.Sprintf("%s/%d.%s", prefix, width, ext)
+		if err := u.blobStore.Put(pctx, key, contentType, buf.Bytes()); err != nil {
+			return nil, err
+		}
+		res.Thumbnails[strconv.Itoa(width)] = baseUrl + "/" + key
+	}
+
+	if itemId != "" {
+		if err := u.itemRepository.UpdateOneItem(pctx, itemId, bson.M{
+			"image_url":  res.ImageUrl,
+			"thumbnails": res.Thumbnails,
+			"updated_at": utils.LocalTime(),
+		}); err != nil {
+			return nil, err
+		}
+
+		u.recordRevision(pctx, cfg, editorId, itemId, "update_image", before)
+	}
+
+	return res, nil
+}
+
+func (u *itemUsecase) FindImage(pctx context.Context, key string) (io.ReadCloser, string, error) {
+	return u.blobStore.Get(pctx, key)
+}
+
+// thumbnail scales the image down to the given width keeping its ratio, smaller images are kept as is
+func thumbnail(img image.Image, width int) image.Image {
+	bounds := img.Bounds()
+	if bounds.Dx() <= width {
+		return img
+	}
+
+	height := bounds.Dy() * width / bounds.Dx()
+	if height < 1 {
+		height = 1
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Over, nil)
+	return dst
+}
+
+func encodeImage(w io.Writer, ext string, img image.Image) error {
+	if ext == "png" {
+		return png.Encode(w, img)
+	}
+	return jpeg.Encode(w, img, &jpeg.Options{Quality: 85})
+}
@@ -0,0 +1,217 @@
+package itemUsecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/Applessr/hello-sekai-shop-tutorial/config"
+	"github.com/Applessr/hello-sekai-shop-tutorial/modules/item"
+	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/utils"
+	"github.com/go-playground/validator/v10"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (u *itemUsecase) ImportItems(pctx context.Context, cfg *config.Config, editorId string, rows []*item.CatalogRow, upsert, dryRun bool) (*item.ItemImportRes, error) {
+	if len(rows) == 0 {
+		return nil, errors.New("error: catalog is empty")
+	}
+
+	validate := validator.New()
+	seen := make(map[string]int)
+
+	res := &item.ItemImportRes{
+		DryRun: dryRun,
+		Rows:   make([]*item.ItemImportRowResult, 0),
+	}
+	for _, row := range rows {
+		result := u.importItem(pctx, cfg, editorId, validate, seen, row, upsert, dryRun)
+		switch result.Action {
+		case "create":
+			res.Created++
+		case "update":
+			res.Updated++
+		case "unchanged":
+			res.Unchanged++
+		default:
+			res.Failed++
+		}
+		res.Rows = append(res.Rows, result)
+	}
+
+	return res, nil
+}
+
+func (u *itemUsecase) importItem(pctx context.Context, cfg *config.Config, editorId string, validate *validator.Validate, seen map[string]int, row *item.CatalogRow, upsert, dryRun bool) *item.ItemImportRowResult {
+	result := &item.ItemImportRowResult{
+		Line:   row.Line,
+		Title:  row.Title,
+		Action: "error",
+	}
+
+	if row.ParseError != "" {
+		result.Error = row.ParseError
+		return result
+	}
+	if err := validate.Struct(row); err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	if err := validateAttributes(row.Attributes); err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	if line, ok := seen[row.Title]; ok {
+		result.Error = fmt.Sprintf("duplicate title, first seen on line %d", line)
+		return result
+	}
+	seen[row.Title] = row.Line
+
+	bundleItems, err := u.validateBundleItems(pctx, row.BundleItems)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+
+	usageStatus := true
+	if row.UsageStatus != nil {
+		usageStatus = *row.UsageStatus
+	}
+
+	if u.itemRepository.IsUniqueItem(pctx, row.Title) {
+		result.Action = "create"
+		if dryRun {
+			return result
+		}
+
+		itemId, err := u.itemRepository.InsertOneItem(pctx, &item.Item{
+			Title:           row.Title,
+			TitleLower:      strings.ToLower(row.Title),
+			Description:     row.Description,
+			Price:           row.Price,
+			Damage:          row.Damage,
+			ImageUrl:        row.ImageUrl,
+			UsageStatus:     usageStatus,
+			Version:         1,
+			CreatedAt:       utils.LocalTime(),
+			UpdatedAt:       utils.LocalTime(),
+			BundleItems:     bundleItems,
+			Category:        row.Category,
+			Rarity:          row.Rarity,
+			Tags:            row.Tags,
+			Attributes:      row.Attributes,
+			Stackable:       row.Stackable,
+			MaxStack:        item.StackLimit(row.Stackable, row.MaxStack),
+			InventorySlots:  row.InventorySlots,
+			EquipType:       row.EquipType,
+			DurationSeconds: row.DurationSeconds,
+		})
+		if err != nil {
+			result.Action = "error"
+			result.Error = err.Error()
+			return result
+		}
+		result.ItemId = "item:" + itemId.Hex()
+		u.recordRevision(pctx, cfg, editorId, itemId.Hex(), "import", nil)
+		return result
+	}
+
+	if !upsert {
+		result.Error = "item already exists"
+		return result
+	}
+
+	existing, err := u.itemRepository.FindOneItemByTitle(pctx, row.Title)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	result.ItemId = "item:" + existing.Id.Hex()
+
+	updateReq := bson.M{}
+	diff := func(field string, from, to any) {
+		if reflect.DeepEqual(from, to) {
+			return
+		}
+		result.Changes = append(result.Changes, &item.FieldChange{Field: field, From: from, To: to})
+		updateReq[field] = to
+	}
+	diff("description", existing.Description, row.Description)
+	diff("price", existing.Price, row.Price)
+	diff("damage", existing.Damage, row.Damage)
+	diff("image_url", existing.ImageUrl, row.ImageUrl)
+	diff("category", existing.Category, row.Category)
+	diff("rarity", existing.Rarity, row.Rarity)
+	diff("tags", emptyToNil(existing.Tags), emptyToNil(row.Tags))
+	diff("bundle_items", emptyToNil(existing.BundleItems), emptyToNil(bundleItems))
+	if len(existing.Attributes) > 0 || len(row.Attributes) > 0 {
+		diff("attributes", map[string]any(existing.Attributes), map[string]any(row.Attributes))
+	}
+	diff("stackable", existing.Stackable, row.Stackable)
+	diff("max_stack", existing.MaxStack, item.StackLimit(row.Stackable, row.MaxStack))
+	diff("inventory_slots", existing.InventorySlots, row.InventorySlots)
+	diff("equip_type", existing.EquipType, row.EquipType)
+	diff("duration_seconds", existing.DurationSeconds, row.DurationSeconds)
+	if row.UsageStatus != nil {
+		diff("usage_status", existing.UsageStatus, *row.UsageStatus)
+	}
+
+	if len(updateReq) == 0 {
+		result.Action = "unchanged"
+		return result
+	}
+	result.Action = "update"
+	if dryRun {
+		return result
+	}
+
+	updateReq["updated_at"] = utils.LocalTime()
+	if err := u.itemRepository.UpdateOneItem(pctx, existing.Id.Hex(), updateReq); err != nil {
+		result.Action = "error"
+		result.Error = err.Error()
+		return result
+	}
+	u.recordRevision(pctx, cfg, editorId, existing.Id.Hex(), "import", existing)
+	return result
+}
+
+func (u *itemUsecase) ExportItems(pctx context.Context) ([]*item.CatalogRow, error) {
+	results, err := u.itemRepository.FindAllItems(pctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]*item.CatalogRow, 0)
+	for _, result := range results {
+		usageStatus := result.UsageStatus
+		rows = append(rows, &item.CatalogRow{
+			Title:           result.Title,
+			Description:     result.Description,
+			Price:           result.Price,
+			Damage:          result.Damage,
+			ImageUrl:        result.ImageUrl,
+			Category:        result.Category,
+			Rarity:          result.Rarity,
+			Tags:            result.Tags,
+			Attributes:      result.Attributes,
+			BundleItems:     result.BundleItems,
+			Stackable:       result.Stackable,
+			MaxStack:        result.MaxStack,
+			InventorySlots:  result.InventorySlots,
+			EquipType:       result.EquipType,
+			DurationSeconds: result.DurationSeconds,
+			UsageStatus:     &usageStatus,
+		})
+	}
+
+	return rows, nil
+}
+
+func emptyToNil(v []string) []string {
+	if len(v) == 0 {
+		return nil
+	}
+	return v
+}
@@ -0,0 +1,216 @@
+package itemUsecase
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+
+	"github.com/Applessr/hello-sekai-shop-tutorial/config"
+	"github.com/Applessr/hello-sekai-shop-tutorial/modules/item"
+	itemPb "github.com/Applessr/hello-sekai-shop-tutorial/modules/item/itemPb"
+	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/utils"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (u *itemUsecase) CreateRecipe(pctx context.Context, req *item.CreateRecipeReq) (*item.RecipeShowCase, error) {
+	outputId := strings.TrimPrefix(req.OutputItemId, "item:")
+
+	inputs := make([]*item.RecipeInput, 0)
+	itemIds := []string{outputId}
+	seen := make(map[string]bool)
+	for _, v := range req.Inputs {
+		inputId := strings.TrimPrefix(v.ItemId, "item:")
+		if inputId == outputId {
+			return nil, errors.New("error: recipe cannot consume its own output")
+		}
+		if seen[inputId] {
+			return nil, errors.New("error: duplicate recipe input")
+		}
+		seen[inputId] = true
+
+		inputs = append(inputs, &item.RecipeInput{
+			ItemId:   "item:" + inputId,
+			Quantity: v.Quantity,
+		})
+		itemIds = append(itemIds, inputId)
+	}
+
+	items, err := u.FindItemInIds(pctx, &itemPb.FindItemInIdsReq{Ids: itemIds})
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[string]bool)
+	for _, v := range items.Items {
+		found[v.Id] = true
+	}
+	for _, v := range itemIds {
+		if !found["item:"+v] {
+			log.Printf("Error: CreateRecipe failed: item %s not found", v)
+			return nil, errors.New("error: recipe item not found")
+		}
+	}
+
+	now := utils.LocalTime()
+	recipeId, err := u.itemRepository.InsertOneRecipe(pctx, &item.Recipe{
+		Title:          req.Title,
+		Inputs:         inputs,
+		Coins:          req.Coins,
+		OutputItemId:   "item:" + outputId,
+		OutputQuantity: req.OutputQuantity,
+		UsageStatus:    true,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return u.FindOneRecipe(pctx, recipeId.Hex())
+}
+
+func (u *itemUsecase) FindOneRecipe(pctx context.Context, recipeId string) (*item.RecipeShowCase, error) {
+	result, err := u.itemRepository.FindOneRecipe(pctx, recipeId)
+	if err != nil {
+		return nil, err
+	}
+
+	return recipeShowCaseOf(result), nil
+}
+
+func (u *itemUsecase) FindRecipes(pctx context.Context) ([]*item.RecipeShowCase, error) {
+	results, err := u.itemRepository.FindRecipes(pctx, bson.D{{"usage_status", true}})
+	if err != nil {
+		return nil, err
+	}
+
+	recipes := make([]*item.RecipeShowCase, 0)
+	for _, v := range results {
+		recipes = append(recipes, recipeShowCaseOf(v))
+	}
+
+	return recipes, nil
+}
+
+func (u *itemUsecase) EnableOrDisableRecipe(pctx context.Context, recipeId string) (bool, error) {
+	result, err := u.itemRepository.FindOneRecipe(pctx, recipeId)
+	if err != nil {
+		return false, err
+	}
+
+	if err := u.itemRepository.EnableOrDisableRecipe(pctx, recipeId, !result.UsageStatus); err != nil {
+		return false, err
+	}
+
+	return !result.UsageStatus, nil
+}
+
+func (u *itemUsecase) GetRecipe(pctx context.Context, req *itemPb.GetRecipeReq) (*itemPb.Recipe, error) {
+	result, err := u.itemRepository.FindOneRecipe(pctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !result.UsageStatus {
+		return nil, errors.New("error: recipe is disabled")
+	}
+
+	inputs := make([]*itemPb.RecipeInput, 0)
+	for _, v := range result.Inputs {
+		inputs = append(inputs, &itemPb.RecipeInput{
+			ItemId:   v.ItemId,
+			Quantity: int32(v.Quantity),
+		})
+	}
+
+	return &itemPb.Recipe{
+		Id:             result.Id.Hex(),
+		Title:          result.Title,
+		Inputs:         inputs,
+		Coins:          result.Coins,
+		OutputItemId:   result.OutputItemId,
+		OutputQuantity: int32(result.OutputQuantity),
+	}, nil
+}
+
+func (u *itemUsecase) UpdateUpgrades(pctx context.Context, cfg *config.Config, editorId, itemId string, req *item.UpdateUpgradesReq) (*item.UpgradePathShowCase, error) {
+	before, err := u.itemRepository.FindOneItem(pctx, itemId)
+	if err != nil {
+		return nil, err
+	}
+
+	tiers := make([]*item.UpgradeTier, 0)
+	for _, v := range req.Tiers {
+		tiers = append(tiers, &item.UpgradeTier{
+			Cost:        v.Cost,
+			Damage:      v.Damage,
+			SuccessRate: v.SuccessRate,
+		})
+	}
+
+	if err := u.itemRepository.UpdateOneItem(pctx, itemId, bson.M{
+		"upgrades":   tiers,
+		"updated_at": utils.LocalTime(),
+	}); err != nil {
+		return nil, err
+	}
+
+	u.recordRevision(pctx, cfg, editorId, itemId, "update_upgrades", before)
+
+	return u.FindUpgradePath(pctx, itemId)
+}
+
+func (u *itemUsecase) FindUpgradePath(pctx context.Context, itemId string) (*item.UpgradePathShowCase, error) {
+	result, err := u.itemRepository.FindOneItem(pctx, itemId)
+	if err != nil {
+		return nil, errors.New("error: find one item not found")
+	}
+
+	tiers := result.Upgrades
+	if tiers == nil {
+		tiers = make([]*item.UpgradeTier, 0)
+	}
+
+	return &item.UpgradePathShowCase{
+		ItemId: "item:" + result.Id.Hex(),
+		Title:  result.Title,
+		Tiers:  tiers,
+	}, nil
+}
+
+func (u *itemUsecase) GetUpgradePath(pctx context.Context, req *itemPb.GetUpgradePathReq) (*itemPb.UpgradePath, error) {
+	result, err := u.itemRepository.FindOneItem(pctx, strings.TrimPrefix(req.Id, "item:"))
+	if err != nil {
+		return nil, err
+	}
+
+	if !result.UsageStatus {
+		return nil, errors.New("error: item is disabled")
+	}
+
+	tiers := make([]*itemPb.UpgradeTier, 0)
+	for _, v := range result.Upgrades {
+		tiers = append(tiers, &itemPb.UpgradeTier{
+			Cost:        v.Cost,
+			Damage:      int32(v.Damage),
+			SuccessRate: v.SuccessRate,
+		})
+	}
+
+	return &itemPb.UpgradePath{
+		Id:    "item:" + result.Id.Hex(),
+		Tiers: tiers,
+	}, nil
+}
+
+func recipeShowCaseOf(v *item.Recipe) *item.RecipeShowCase {
+	return &item.RecipeShowCase{
+		RecipeId:       v.Id.Hex(),
+		Title:          v.Title,
+		Inputs:         v.Inputs,
+		Coins:          v.Coins,
+		OutputItemId:   v.OutputItemId,
+		OutputQuantity: v.OutputQuantity,
+		UsageStatus:    v.UsageStatus,
+	}
+}
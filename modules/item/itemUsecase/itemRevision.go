@@ -0,0 +1,165 @@
+package itemUsecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Applessr/hello-sekai-shop-tutorial/config"
+	"github.com/Applessr/hello-sekai-shop-tutorial/modules/item"
+	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/utils"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// recordRevision stores the item as it is after a change together with the diff from before
+// and publishes the matching event, a failure is only logged so the change itself is not rolled back
+func (u *itemUsecase) recordRevision(pctx context.Context, cfg *config.Config, editorId, itemId, action string, before *item.Item) {
+	u.insertRevision(pctx, cfg, editorId, itemId, action, before, "")
+}
+
+func (u *itemUsecase) insertRevision(pctx context.Context, cfg *config.Config, editorId, itemId, action string, before *item.Item, revertedFrom string) {
+	after, err := u.itemRepository.FindOneItem(pctx, itemId)
+	if err != nil {
+		log.Printf("Error: recordRevision failed: %s", err.Error())
+		return
+	}
+
+	if err := u.itemRepository.InsertOneItemRevision(pctx, &item.ItemRevision{
+		ItemId:       itemId,
+		Action:       action,
+		EditorId:     editorId,
+		Changes:      diffItems(before, after),
+		Snapshot:     after,
+		RevertedFrom: revertedFrom,
+		CreatedAt:    utils.LocalTime(),
+	}); err != nil {
+		log.Printf("Error: recordRevision failed: item %s action %s: %s", itemId, action, err.Error())
+	}
+
+	eventType := "item.updated"
+	switch {
+	case before == nil:
+		eventType = "item.created"
+	case before.UsageStatus && !after.UsageStatus:
+		eventType = "item.disabled"
+	}
+
+	event := &item.ItemEvent{
+		EventId:    primitive.NewObjectID().Hex(),
+		Type:       eventType,
+		ItemId:     "item:" + itemId,
+		Version:    after.Version,
+		Item:       after,
+		OccurredAt: utils.LocalTime(),
+	}
+	if err := u.itemRepository.PublishItemEvent(pctx, cfg, event); err != nil {
+		log.Printf("Error: recordRevision failed: publish %s for item %s: %s", eventType, itemId, err.Error())
+	}
+}
+
+// diffItems compares the json form of both items so every field is covered without listing them
+func diffItems(before, after *item.Item) []*item.FieldChange {
+	toMap := func(v *item.Item) map[string]any {
+		m := make(map[string]any)
+		if v == nil {
+			return m
+		}
+		b, _ := json.Marshal(v)
+		json.Unmarshal(b, &m)
+		delete(m, "_id")
+		delete(m, "created_at")
+		delete(m, "updated_at")
+		return m
+	}
+	from, to := toMap(before), toMap(after)
+
+	fields := make([]string, 0)
+	for k := range from {
+		fields = append(fields, k)
+	}
+	for k := range to {
+		if _, ok := from[k]; !ok {
+			fields = append(fields, k)
+		}
+	}
+	sort.Strings(fields)
+
+	changes := make([]*item.FieldChange, 0)
+	for _, k := range fields {
+		if reflect.DeepEqual(from[k], to[k]) {
+			continue
+		}
+		changes = append(changes, &item.FieldChange{Field: k, From: from[k], To: to[k]})
+	}
+	return changes
+}
+
+func (u *itemUsecase) FindItemHistory(pctx context.Context, itemId string) ([]*item.ItemRevisionShowCase, error) {
+	if _, err := u.itemRepository.FindOneItem(pctx, itemId); err != nil {
+		return nil, errors.New("error: find one item not found")
+	}
+
+	results, err := u.itemRepository.FindItemRevisions(pctx, itemId)
+	if err != nil {
+		return nil, err
+	}
+
+	loc, _ := time.LoadLocation("Asia/Bangkok")
+
+	res := make([]*item.ItemRevisionShowCase, 0)
+	for _, r := range results {
+		changes := r.Changes
+		if changes == nil {
+			changes = make([]*item.FieldChange, 0)
+		}
+		res = append(res, &item.ItemRevisionShowCase{
+			RevisionId:   r.Id.Hex(),
+			ItemId:       "item:" + r.ItemId,
+			Action:       r.Action,
+			EditorId:     r.EditorId,
+			Changes:      changes,
+			RevertedFrom: r.RevertedFrom,
+			CreatedAt:    r.CreatedAt.In(loc),
+		})
+	}
+
+	return res, nil
+}
+
+func (u *itemUsecase) RevertItem(pctx context.Context, cfg *config.Config, editorId, itemId, revisionId string) (*item.ItemShowCase, error) {
+	before, err := u.itemRepository.FindOneItem(pctx, itemId)
+	if err != nil {
+		return nil, errors.New("error: find one item not found")
+	}
+
+	revision, err := u.itemRepository.FindOneItemRevision(pctx, itemId, revisionId)
+	if err != nil {
+		return nil, err
+	}
+	if revision.Snapshot == nil {
+		return nil, errors.New("error: item revision has no snapshot")
+	}
+
+	if revision.Snapshot.Title != before.Title && !u.itemRepository.IsUniqueItem(pctx, revision.Snapshot.Title) {
+		return nil, errors.New("error: this title is already exist")
+	}
+
+	snapshot := revision.Snapshot
+	snapshot.TitleLower = strings.ToLower(snapshot.Title)
+	snapshot.CreatedAt = before.CreatedAt
+	snapshot.UpdatedAt = utils.LocalTime()
+	snapshot.Version = before.Version + 1
+
+	if err := u.itemRepository.ReplaceOneItem(pctx, itemId, snapshot); err != nil {
+		return nil, err
+	}
+
+	u.insertRevision(pctx, cfg, editorId, itemId, "revert", before, revisionId)
+
+	return u.FindOneItem(pctx, itemId)
+}
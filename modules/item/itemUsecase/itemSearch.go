@@ -0,0 +1,118 @@
+package itemUsecase
+
+import (
+	"errors"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Applessr/hello-sekai-shop-tutorial/modules/item"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var attributeKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,31}$`)
+
+func validateAttributes(attributes map[string]any) error {
+	for k, v := range attributes {
+		if !attributeKeyPattern.MatchString(k) {
+			return errors.New("error: invalid attribute key: " + k)
+		}
+		switch v.(type) {
+		case string, float64, bool:
+		default:
+			return errors.New("error: attribute must be a string, number or boolean: " + k)
+		}
+	}
+	return nil
+}
+
+func rangeFilter[T int | float64](min, max T) bson.D {
+	filter := bson.D{}
+	if min > 0 {
+		filter = append(filter, bson.E{"$gte", min})
+	}
+	if max > 0 {
+		filter = append(filter, bson.E{"$lte", max})
+	}
+	return filter
+}
+
+// priceRangeExpr keeps the items whose effective price at the given time is within min and max,
+// a bound of 0 is left open like rangeFilter
+func priceRangeExpr(at time.Time, min, max float64) bson.D {
+	price := item.EffectivePriceExpr(at)
+
+	bounds := bson.A{}
+	if min > 0 {
+		bounds = append(bounds, bson.D{{"$gte", bson.A{price, min}}})
+	}
+	if max > 0 {
+		bounds = append(bounds, bson.D{{"$lte", bson.A{price, max}}})
+	}
+	return bson.D{{"$and", bounds}}
+}
+
+// attributeFilter parses "key:value" or "key:min..max" into a filter on attributes.key
+func attributeFilter(attr string) (primitive.E, error) {
+	key, value, ok := strings.Cut(attr, ":")
+	if !ok || !attributeKeyPattern.MatchString(key) || value == "" {
+		return primitive.E{}, errors.New("error: invalid attribute filter: " + attr)
+	}
+	field := "attributes." + key
+
+	if from, to, ok := strings.Cut(value, ".."); ok {
+		min, err1 := strconv.ParseFloat(from, 64)
+		max, err2 := strconv.ParseFloat(to, 64)
+		if err1 != nil || err2 != nil {
+			return primitive.E{}, errors.New("error: invalid attribute range: " + attr)
+		}
+		return bson.E{field, bson.D{{"$gte", min}, {"$lte", max}}}, nil
+	}
+	if n, err := strconv.ParseFloat(value, 64); err == nil {
+		return bson.E{field, n}, nil
+	}
+	if b, err := strconv.ParseBool(value); err == nil {
+		return bson.E{field, b}, nil
+	}
+	return bson.E{field, value}, nil
+}
+
+func itemSearchQuery(req *item.ItemSearchReq) url.Values {
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(req.Limit))
+	query.Set("title", req.Title)
+	if req.Q != "" {
+		query.Set("q", req.Q)
+	}
+	if req.Category != "" {
+		query.Set("category", req.Category)
+	}
+	if req.Rarity != "" {
+		query.Set("rarity", req.Rarity)
+	}
+	for _, tag := range req.Tags {
+		query.Add("tag", tag)
+	}
+	for _, attr := range req.Attrs {
+		query.Add("attr", attr)
+	}
+	if req.MinPrice > 0 {
+		query.Set("min_price", strconv.FormatFloat(req.MinPrice, 'f', -1, 64))
+	}
+	if req.MaxPrice > 0 {
+		query.Set("max_price", strconv.FormatFloat(req.MaxPrice, 'f', -1, 64))
+	}
+	if req.MinDamage > 0 {
+		query.Set("min_damage", strconv.Itoa(req.MinDamage))
+	}
+	if req.MaxDamage > 0 {
+		query.Set("max_damage", strconv.Itoa(req.MaxDamage))
+	}
+	if req.Sort != "" {
+		query.Set("sort", req.Sort)
+	}
+	return query
+}
@@ -0,0 +1,48 @@
+package itemUsecase
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type (
+	testAttributeFilter struct {
+		attr     string
+		expected primitive.E
+		isErr    bool
+	}
+)
+
+func TestAttributeFilter(t *testing.T) {
+	tests := []testAttributeFilter{
+		{attr: "element:fire", expected: bson.E{"attributes.element", "fire"}},
+		{attr: "level:10", expected: bson.E{"attributes.level", 10.0}},
+		{attr: "speed:1.5", expected: bson.E{"attributes.speed", 1.5}},
+		{attr: "tradable:true", expected: bson.E{"attributes.tradable", true}},
+		{attr: "level:5..10", expected: bson.E{"attributes.level", bson.D{{"$gte", 5.0}, {"$lte", 10.0}}}},
+		{attr: "note:a:b", expected: bson.E{"attributes.note", "a:b"}},
+		{attr: "level:5..x", isErr: true},
+		{attr: "level:5..", isErr: true},
+		{attr: "level:", isErr: true},
+		{attr: "level", isErr: true},
+		{attr: "Level:1", isErr: true},
+		{attr: "$where:1", isErr: true},
+		{attr: "a.b:1", isErr: true},
+	}
+
+	for i, test := range tests {
+		fmt.Printf("case -> %d\n", i+1)
+
+		result, err := attributeFilter(test.attr)
+
+		if test.isErr {
+			assert.NotEmpty(t, err)
+		} else {
+			assert.Equal(t, test.expected, result)
+		}
+	}
+}
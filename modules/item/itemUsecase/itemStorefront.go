@@ -0,0 +1,268 @@
+package itemUsecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/Applessr/hello-sekai-shop-tutorial/modules/item"
+	itemPb "github.com/Applessr/hello-sekai-shop-tutorial/modules/item/itemPb"
+	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/gacha"
+	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// storefrontWindow returns the rotation window now falls in, days start at midnight local time
+// and weeks on Monday
+func storefrontWindow(period string, now time.Time) (time.Time, time.Time) {
+	loc, _ := time.LoadLocation("Asia/Bangkok")
+	now = now.In(loc)
+
+	start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	if period == "weekly" {
+		start = start.AddDate(0, 0, -((int(start.Weekday()) + 6) % 7))
+		return start, start.AddDate(0, 0, 7)
+	}
+	return start, start.AddDate(0, 0, 1)
+}
+
+// storefrontRotation lists the items the rule features for the player in the window that opened at start
+func storefrontRotation(rule *item.StorefrontRule, playerId string, start time.Time) []string {
+	if rule.Mode == "curated" {
+		for _, v := range rule.Schedule {
+			if v.StartsAt.Equal(start) {
+				return v.ItemIds
+			}
+		}
+		return make([]string, 0)
+	}
+
+	// The shuffle is seeded by the player and the window, so the deal holds until the next refresh
+	pool := append(make([]string, 0, len(rule.Pool)), rule.Pool...)
+	clientSeed := fmt.Sprintf("%s:%d", playerId, start.Unix())
+	for i := len(pool) - 1; i > 0; i-- {
+		j := int(gacha.Roll(rule.Seed, clientSeed, int64(i)) * float64(i+1))
+		pool[i], pool[j] = pool[j], pool[i]
+	}
+	if len(pool) > rule.Slots {
+		pool = pool[:rule.Slots]
+	}
+	return pool
+}
+
+// storefrontManaged lists every item the rules may feature, these are only sold through the rotation
+func storefrontManaged(rules []*item.StorefrontRule) map[string]bool {
+	managed := make(map[string]bool)
+	for _, rule := range rules {
+		for _, v := range rule.Pool {
+			managed[v] = true
+		}
+		for _, s := range rule.Schedule {
+			for _, v := range s.ItemIds {
+				managed[v] = true
+			}
+		}
+	}
+	return managed
+}
+
+func (u *itemUsecase) CreateStorefrontRule(pctx context.Context, req *item.CreateStorefrontRuleReq) (*item.StorefrontRule, error) {
+	loc, _ := time.LoadLocation("Asia/Bangkok")
+
+	itemIds := make([]string, 0)
+	normalize := func(ids []string) []string {
+		results := make([]string, 0)
+		for _, v := range ids {
+			itemId := strings.TrimPrefix(v, "item:")
+			results = append(results, "item:"+itemId)
+			itemIds = append(itemIds, itemId)
+		}
+		return results
+	}
+
+	rule := &item.StorefrontRule{
+		Title:       req.Title,
+		Period:      req.Period,
+		Mode:        req.Mode,
+		Seed:        gacha.NewServerSeed(),
+		UsageStatus: true,
+	}
+
+	switch req.Mode {
+	case "random":
+		if req.Slots < 1 || len(req.Pool) == 0 {
+			return nil, errors.New("error: random rule needs slots and a pool")
+		}
+		if len(req.Schedule) > 0 {
+			return nil, errors.New("error: random rule can't have a schedule")
+		}
+		rule.Slots = req.Slots
+		rule.Pool = normalize(req.Pool)
+	case "curated":
+		if len(req.Schedule) == 0 {
+			return nil, errors.New("error: curated rule needs a schedule")
+		}
+		if len(req.Pool) > 0 {
+			return nil, errors.New("error: curated rule can't have a pool")
+		}
+
+		seen := make(map[time.Time]bool)
+		for _, v := range req.Schedule {
+			startsAt, err := time.ParseInLocation("2006-01-02", v.StartsAt, loc)
+			if err != nil {
+				return nil, errors.New("error: starts_at must be a date like 2006-01-02")
+			}
+			// Each entry fills the whole window its date falls in
+			start, _ := storefrontWindow(req.Period, startsAt)
+			if seen[start] {
+				return nil, errors.New("error: schedule has two entries for the same window")
+			}
+			seen[start] = true
+
+			rule.Schedule = append(rule.Schedule, &item.StorefrontRotation{
+				StartsAt: start,
+				ItemIds:  normalize(v.ItemIds),
+			})
+		}
+	}
+
+	items, err := u.FindItemInIds(pctx, &itemPb.FindItemInIdsReq{Ids: itemIds})
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[string]bool)
+	for _, v := range items.Items {
+		found[v.Id] = true
+	}
+	for _, v := range itemIds {
+		if !found["item:"+v] {
+			log.Printf("Error: CreateStorefrontRule failed: item %s not found", v)
+			return nil, errors.New("error: storefront item not found")
+		}
+	}
+
+	now := utils.LocalTime()
+	rule.CreatedAt = now
+	rule.UpdatedAt = now
+
+	ruleId, err := u.itemRepository.InsertOneStorefrontRule(pctx, rule)
+	if err != nil {
+		return nil, err
+	}
+	rule.Id = ruleId
+
+	return rule, nil
+}
+
+func (u *itemUsecase) FindStorefrontRules(pctx context.Context) ([]*item.StorefrontRule, error) {
+	return u.itemRepository.FindStorefrontRules(pctx, bson.D{})
+}
+
+func (u *itemUsecase) EnableOrDisableStorefrontRule(pctx context.Context, ruleId string) (bool, error) {
+	result, err := u.itemRepository.FindOneStorefrontRule(pctx, ruleId)
+	if err != nil {
+		return false, err
+	}
+
+	if err := u.itemRepository.EnableOrDisableStorefrontRule(pctx, ruleId, !result.UsageStatus); err != nil {
+		return false, err
+	}
+
+	return !result.UsageStatus, nil
+}
+
+func (u *itemUsecase) FindStorefront(pctx context.Context, playerId string) (*item.StorefrontRes, error) {
+	rules, err := u.itemRepository.FindStorefrontRules(pctx, bson.D{{"usage_status", true}})
+	if err != nil {
+		return nil, err
+	}
+
+	now := utils.LocalTime()
+
+	rotations := make([][]string, 0)
+	objectIds := make([]primitive.ObjectID, 0)
+	for _, rule := range rules {
+		start, _ := storefrontWindow(rule.Period, now)
+		rotation := storefrontRotation(rule, playerId, start)
+		for _, v := range rotation {
+			objectIds = append(objectIds, utils.ConvertToObjectId(strings.TrimPrefix(v, "item:")))
+		}
+		rotations = append(rotations, rotation)
+	}
+
+	itemMaps := make(map[string]*item.ItemShowCase)
+	if len(objectIds) > 0 {
+		items, err := u.itemRepository.FindManyItems(pctx, bson.D{{"_id", bson.D{{"$in", objectIds}}}, {"usage_status", true}}, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range items {
+			itemMaps[v.ItemId] = v
+		}
+	}
+
+	res := &item.StorefrontRes{
+		Slots: make([]*item.StorefrontSlot, 0),
+	}
+	for i, rule := range rules {
+		_, end := storefrontWindow(rule.Period, now)
+
+		// Items disabled since the rotation was set up drop out instead of failing the page
+		items := make([]*item.ItemShowCase, 0)
+		for _, v := range rotations[i] {
+			if showCase, ok := itemMaps[v]; ok {
+				items = append(items, showCase)
+			}
+		}
+
+		res.Slots = append(res.Slots, &item.StorefrontSlot{
+			RuleId:    rule.Id.Hex(),
+			Title:     rule.Title,
+			Period:    rule.Period,
+			Items:     items,
+			RefreshAt: end,
+			RefreshIn: int64(end.Sub(now) / time.Second),
+		})
+
+		if res.RefreshAt == nil || end.Before(*res.RefreshAt) {
+			res.RefreshAt = &end
+			res.RefreshIn = int64(end.Sub(now) / time.Second)
+		}
+	}
+
+	return res, nil
+}
+
+// CheckStorefront blocks the items a storefront rule sells that are not in the player's rotation
+// right now, items no rule mentions stay on sale as before
+func (u *itemUsecase) CheckStorefront(pctx context.Context, req *itemPb.CheckStorefrontReq) (*itemPb.CheckStorefrontRes, error) {
+	rules, err := u.itemRepository.FindStorefrontRules(pctx, bson.D{{"usage_status", true}})
+	if err != nil {
+		return nil, err
+	}
+
+	now := utils.LocalTime()
+
+	managed := storefrontManaged(rules)
+	featured := make(map[string]bool)
+	for _, rule := range rules {
+		start, _ := storefrontWindow(rule.Period, now)
+		for _, v := range storefrontRotation(rule, req.PlayerId, start) {
+			featured[v] = true
+		}
+	}
+
+	blocked := make([]string, 0)
+	for _, v := range req.ItemIds {
+		itemId := "item:" + strings.TrimPrefix(v, "item:")
+		if managed[itemId] && !featured[itemId] {
+			blocked = append(blocked, v)
+		}
+	}
+
+	return &itemPb.CheckStorefrontRes{Blocked: blocked}, nil
+}
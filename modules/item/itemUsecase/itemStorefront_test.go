@@ -0,0 +1,152 @@
+package itemUsecase
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Applessr/hello-sekai-shop-tutorial/modules/item"
+	"github.com/stretchr/testify/assert"
+)
+
+type (
+	testStorefrontWindow struct {
+		period string
+		now    time.Time
+		start  time.Time
+		end    time.Time
+	}
+
+	testStorefrontRotation struct {
+		rule     *item.StorefrontRule
+		playerId string
+		start    time.Time
+		expected []string
+	}
+)
+
+func TestStorefrontWindow(t *testing.T) {
+	loc, _ := time.LoadLocation("Asia/Bangkok")
+
+	tests := []testStorefrontWindow{
+		{
+			// Daily mid day
+			period: "daily",
+			now:    time.Date(2026, 10, 14, 15, 30, 0, 0, loc),
+			start:  time.Date(2026, 10, 14, 0, 0, 0, 0, loc),
+			end:    time.Date(2026, 10, 15, 0, 0, 0, 0, loc),
+		},
+		{
+			// Daily at midnight
+			period: "daily",
+			now:    time.Date(2026, 10, 14, 0, 0, 0, 0, loc),
+			start:  time.Date(2026, 10, 14, 0, 0, 0, 0, loc),
+			end:    time.Date(2026, 10, 15, 0, 0, 0, 0, loc),
+		},
+		{
+			// Days follow Bangkok, not UTC
+			period: "daily",
+			now:    time.Date(2026, 10, 14, 18, 0, 0, 0, time.UTC),
+			start:  time.Date(2026, 10, 15, 0, 0, 0, 0, loc),
+			end:    time.Date(2026, 10, 16, 0, 0, 0, 0, loc),
+		},
+		{
+			// Weekly on Monday
+			period: "weekly",
+			now:    time.Date(2026, 10, 12, 0, 0, 0, 0, loc),
+			start:  time.Date(2026, 10, 12, 0, 0, 0, 0, loc),
+			end:    time.Date(2026, 10, 19, 0, 0, 0, 0, loc),
+		},
+		{
+			// Weekly mid week
+			period: "weekly",
+			now:    time.Date(2026, 10, 15, 12, 0, 0, 0, loc),
+			start:  time.Date(2026, 10, 12, 0, 0, 0, 0, loc),
+			end:    time.Date(2026, 10, 19, 0, 0, 0, 0, loc),
+		},
+		{
+			// Weekly, last second of Sunday
+			period: "weekly",
+			now:    time.Date(2026, 10, 18, 23, 59, 59, 0, loc),
+			start:  time.Date(2026, 10, 12, 0, 0, 0, 0, loc),
+			end:    time.Date(2026, 10, 19, 0, 0, 0, 0, loc),
+		},
+		{
+			// Weekly across a month
+			period: "weekly",
+			now:    time.Date(2026, 11, 1, 9, 0, 0, 0, loc),
+			start:  time.Date(2026, 10, 26, 0, 0, 0, 0, loc),
+			end:    time.Date(2026, 11, 2, 0, 0, 0, 0, loc),
+		},
+		{
+			// Sunday in UTC is already Monday in Bangkok
+			period: "weekly",
+			now:    time.Date(2026, 10, 18, 17, 0, 0, 0, time.UTC),
+			start:  time.Date(2026, 10, 19, 0, 0, 0, 0, loc),
+			end:    time.Date(2026, 10, 26, 0, 0, 0, 0, loc),
+		},
+	}
+
+	for i, test := range tests {
+		fmt.Printf("case -> %d\n", i+1)
+
+		start, end := storefrontWindow(test.period, test.now)
+		assert.True(t, test.start.Equal(start), "start %s", start)
+		assert.True(t, test.end.Equal(end), "end %s", end)
+	}
+}
+
+func TestStorefrontRotation(t *testing.T) {
+	loc, _ := time.LoadLocation("Asia/Bangkok")
+	week := time.Date(2026, 10, 12, 0, 0, 0, 0, loc)
+
+	pool := []string{"item:1", "item:2", "item:3", "item:4", "item:5", "item:6"}
+	random := &item.StorefrontRule{Mode: "random", Slots: 3, Pool: pool, Seed: "seed"}
+	curated := &item.StorefrontRule{Mode: "curated", Schedule: []*item.StorefrontRotation{
+		{StartsAt: week, ItemIds: []string{"item:1", "item:2"}},
+		{StartsAt: week.AddDate(0, 0, 7), ItemIds: []string{"item:3"}},
+	}}
+
+	tests := []testStorefrontRotation{
+		{rule: curated, playerId: "player:001", start: week, expected: []string{"item:1", "item:2"}},
+		{rule: curated, playerId: "player:001", start: week.AddDate(0, 0, 7), expected: []string{"item:3"}},
+		{rule: curated, playerId: "player:001", start: week.AddDate(0, 0, 14), expected: []string{}},
+		{rule: &item.StorefrontRule{Mode: "random", Slots: 5, Pool: []string{"item:1", "item:2"}, Seed: "seed"}, playerId: "player:001", start: week},
+		{rule: random, playerId: "player:001", start: week},
+		{rule: random, playerId: "player:002", start: week},
+		{rule: random, playerId: "player:001", start: week.AddDate(0, 0, 7)},
+	}
+
+	for i, test := range tests {
+		fmt.Printf("case -> %d\n", i+1)
+
+		rotation := storefrontRotation(test.rule, test.playerId, test.start)
+		if test.rule.Mode == "curated" {
+			assert.Equal(t, test.expected, rotation)
+			continue
+		}
+
+		// The same player gets the same deal for the whole window, taken from the pool without repeats
+		assert.Equal(t, rotation, storefrontRotation(test.rule, test.playerId, test.start))
+		assert.Len(t, rotation, min(test.rule.Slots, len(test.rule.Pool)))
+		seen := make(map[string]bool)
+		for _, v := range rotation {
+			assert.Contains(t, test.rule.Pool, v)
+			assert.False(t, seen[v])
+			seen[v] = true
+		}
+	}
+
+	// The pool itself is left in order
+	assert.Equal(t, []string{"item:1", "item:2", "item:3", "item:4", "item:5", "item:6"}, pool)
+
+	// Shuffles differ between players and windows, one of several pairs may collide by chance
+	deals := make(map[string]bool)
+	for _, playerId := range []string{"player:001", "player:002", "player:003", "player:004"} {
+		for i := 0; i < 4; i++ {
+			deal := storefrontRotation(random, playerId, week.AddDate(0, 0, 7*i))
+			deals[deal[0]+deal[1]+deal[2]] = true
+		}
+	}
+	assert.Greater(t, len(deals), 1)
+}
@@ -4,14 +4,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"log"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/Applessr/hello-sekai-shop-tutorial/config"
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/item"
 	itemPb "github.com/Applessr/hello-sekai-shop-tutorial/modules/item/itemPb"
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/item/itemRepository"
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/models"
+	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/blobstore"
 	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/utils"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
@@ -20,43 +25,100 @@ import (
 
 type (
 	ItemUsecaseService interface {
-		CreatedItem(pctx context.Context, req *item.CreateItemReq) (*item.ItemShowCase, error)
+		CreatedItem(pctx context.Context, cfg *config.Config, editorId string, req *item.CreateItemReq) (*item.ItemShowCase, error)
 		FindOneItem(pctx context.Context, itemId string) (*item.ItemShowCase, error)
-		FindManyItem(pctx context.Context, basePaginateUrl string, req *item.ItemSearchReq) (*models.PaginateRes, error)
-		EditItem(pctx context.Context, itemId string, req *item.ItemUpdateReq) (*item.ItemShowCase, error)
-		EnableOrDisableItem(pctx context.Context, itemId string) (bool, error)
+		FindManyItem(pctx context.Context, basePaginateUrl string, req *item.ItemSearchReq) (*item.ItemSearchRes, error)
+		ImportItems(pctx context.Context, cfg *config.Config, editorId string, rows []*item.CatalogRow, upsert, dryRun bool) (*item.ItemImportRes, error)
+		ExportItems(pctx context.Context) ([]*item.CatalogRow, error)
+		FindItemHistory(pctx context.Context, itemId string) ([]*item.ItemRevisionShowCase, error)
+		RevertItem(pctx context.Context, cfg *config.Config, editorId, itemId, revisionId string) (*item.ItemShowCase, error)
+		UploadImage(pctx context.Context, cfg *config.Config, editorId, itemId string, data []byte) (*item.ItemImageRes, error)
+		FindImage(pctx context.Context, key string) (io.ReadCloser, string, error)
+		SuggestItems(pctx context.Context, req *item.ItemSuggestReq) ([]*item.ItemSuggestion, error)
+		EditItem(pctx context.Context, cfg *config.Config, editorId, itemId string, req *item.ItemUpdateReq) (*item.ItemShowCase, error)
+		EnableOrDisableItem(pctx context.Context, cfg *config.Config, editorId, itemId string) (bool, error)
 		FindItemInIds(pctx context.Context, req *itemPb.FindItemInIdsReq) (*itemPb.FindItemInIdsRes, error)
+		UpdateLootBox(pctx context.Context, cfg *config.Config, editorId, itemId string, req *item.UpdateLootBoxReq) (*item.LootBoxShowCase, error)
+		FindLootBoxDropRates(pctx context.Context, itemId string) (*item.LootBoxShowCase, error)
+		FindOneLootBox(pctx context.Context, req *itemPb.FindOneLootBoxReq) (*itemPb.LootBox, error)
+		WatchCatalog(pctx context.Context, req *itemPb.WatchCatalogReq, send func(*itemPb.CatalogEvent) error) error
+		ListItems(pctx context.Context, req *itemPb.ListItemsReq) (*itemPb.ListItemsRes, error)
+		GetItemPrices(pctx context.Context, req *itemPb.GetItemPricesReq) (*itemPb.GetItemPricesRes, error)
+		CreatePriceSchedule(pctx context.Context, cfg *config.Config, editorId, itemId string, req *item.CreatePriceScheduleReq) ([]*item.PriceSchedule, error)
+		FindPriceSchedules(pctx context.Context, itemId string) ([]*item.PriceSchedule, error)
+		DeletePriceSchedule(pctx context.Context, cfg *config.Config, editorId, itemId, scheduleId string) ([]*item.PriceSchedule, error)
+		AnnouncePriceSchedules(pctx context.Context, cfg *config.Config)
+		BroadcastItemEvent(event *item.ItemEvent)
+		CreateRecipe(pctx context.Context, req *item.CreateRecipeReq) (*item.RecipeShowCase, error)
+		FindOneRecipe(pctx context.Context, recipeId string) (*item.RecipeShowCase, error)
+		FindRecipes(pctx context.Context) ([]*item.RecipeShowCase, error)
+		EnableOrDisableRecipe(pctx context.Context, recipeId string) (bool, error)
+		GetRecipe(pctx context.Context, req *itemPb.GetRecipeReq) (*itemPb.Recipe, error)
+		UpdateUpgrades(pctx context.Context, cfg *config.Config, editorId, itemId string, req *item.UpdateUpgradesReq) (*item.UpgradePathShowCase, error)
+		FindUpgradePath(pctx context.Context, itemId string) (*item.UpgradePathShowCase, error)
+		GetUpgradePath(pctx context.Context, req *itemPb.GetUpgradePathReq) (*itemPb.UpgradePath, error)
+		CreateStorefrontRule(pctx context.Context, req *item.CreateStorefrontRuleReq) (*item.StorefrontRule, error)
+		FindStorefrontRules(pctx context.Context) ([]*item.StorefrontRule, error)
+		EnableOrDisableStorefrontRule(pctx context.Context, ruleId string) (bool, error)
+		FindStorefront(pctx context.Context, playerId string) (*item.StorefrontRes, error)
+		CheckStorefront(pctx context.Context, req *itemPb.CheckStorefrontReq) (*itemPb.CheckStorefrontRes, error)
 	}
 
 	itemUsecase struct {
 		itemRepository itemRepository.ItemRepositoryService
+		blobStore      blobstore.BlobStoreService
+		catalogHub     *catalogHub
 	}
 )
 
-func NewItemUsecase(itemRepository itemRepository.ItemRepositoryService) ItemUsecaseService {
-	return &itemUsecase{itemRepository}
+func NewItemUsecase(itemRepository itemRepository.ItemRepositoryService, blobStore blobstore.BlobStoreService) ItemUsecaseService {
+	return &itemUsecase{itemRepository, blobStore, newCatalogHub()}
 }
 
-func (u *itemUsecase) CreatedItem(pctx context.Context, req *item.CreateItemReq) (*item.ItemShowCase, error) {
+func (u *itemUsecase) CreatedItem(pctx context.Context, cfg *config.Config, editorId string, req *item.CreateItemReq) (*item.ItemShowCase, error) {
 	if !u.itemRepository.IsUniqueItem(pctx, req.Title) {
 		return nil, errors.New("error: item already exists")
 	}
 
+	bundleItems, err := u.validateBundleItems(pctx, req.BundleItems)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateAttributes(req.Attributes); err != nil {
+		return nil, err
+	}
+
 	loc, _ := time.LoadLocation("Asia/Bangkok")
 
 	itemId, err := u.itemRepository.InsertOneItem(pctx, &item.Item{
-		Title:       req.Title,
-		Price:       req.Price,
-		Damage:      req.Damage,
-		UsageStatus: true,
-		ImageUrl:    req.ImageUrl,
-		CreatedAt:   utils.LocalTime().In(loc),
-		UpdatedAt:   utils.LocalTime().In(loc),
+		Title:           req.Title,
+		TitleLower:      strings.ToLower(req.Title),
+		Description:     req.Description,
+		Price:           req.Price,
+		Damage:          req.Damage,
+		UsageStatus:     true,
+		Version:         1,
+		ImageUrl:        req.ImageUrl,
+		CreatedAt:       utils.LocalTime().In(loc),
+		UpdatedAt:       utils.LocalTime().In(loc),
+		BundleItems:     bundleItems,
+		Category:        req.Category,
+		Rarity:          req.Rarity,
+		Tags:            req.Tags,
+		Attributes:      req.Attributes,
+		Stackable:       req.Stackable,
+		MaxStack:        item.StackLimit(req.Stackable, req.MaxStack),
+		InventorySlots:  req.InventorySlots,
+		EquipType:       req.EquipType,
+		DurationSeconds: req.DurationSeconds,
 	})
 	if err != nil {
 		return nil, errors.New("error: insert item failed")
 	}
 
+	u.recordRevision(pctx, cfg, editorId, itemId.Hex(), "create", nil)
+
 	return u.FindOneItem(pctx, itemId.Hex())
 }
 
@@ -65,39 +127,171 @@ func (u *itemUsecase) FindOneItem(pctx context.Context, itemId string) (*item.It
 	if err != nil {
 		return nil, errors.New("error: find one item not found")
 	}
-	return &item.ItemShowCase{
-		ItemId:   result.Id.Hex(),
-		Title:    result.Title,
-		Price:    result.Price,
-		Damage:   result.Damage,
-		ImageUrl: result.ImageUrl,
-	}, nil
+	showCase := &item.ItemShowCase{
+		ItemId:          result.Id.Hex(),
+		Title:           result.Title,
+		Description:     result.Description,
+		Price:           item.EffectivePrice(result.Price, result.PriceSchedules, utils.LocalTime()),
+		Damage:          result.Damage,
+		ImageUrl:        result.ImageUrl,
+		BundleItems:     result.BundleItems,
+		Category:        result.Category,
+		Rarity:          result.Rarity,
+		Tags:            result.Tags,
+		Attributes:      result.Attributes,
+		Thumbnails:      result.Thumbnails,
+		Stackable:       result.Stackable,
+		MaxStack:        result.MaxStack,
+		InventorySlots:  result.InventorySlots,
+		EquipType:       result.EquipType,
+		DurationSeconds: result.DurationSeconds,
+		Version:         result.Version,
+	}
+	if showCase.Price != result.Price {
+		showCase.OriginalPrice = result.Price
+	}
+
+	return showCase, nil
 }
 
-func (u *itemUsecase) FindManyItem(pctx context.Context, basePaginateUrl string, req *item.ItemSearchReq) (*models.PaginateRes, error) {
+func (u *itemUsecase) FindManyItem(pctx context.Context, basePaginateUrl string, req *item.ItemSearchReq) (*item.ItemSearchRes, error) {
 	findItemsFilter := bson.D{}
 	findItemOptions := make([]*options.FindOptions, 0)
 
 	countItemsFilter := bson.D{}
 	// filter
-	if req.Start != "" && req.Limit != 0 {
-		req.Start = strings.TrimPrefix(req.Start, "item:")
-		findItemsFilter = append(findItemsFilter, bson.E{"_id", bson.D{{"$gt", utils.ConvertToObjectId(req.Start)}}})
+	if req.Q != "" {
+		countItemsFilter = append(countItemsFilter, bson.E{"$text", bson.D{{"$search", req.Q}}})
 	}
 	if req.Title != "" {
-		findItemsFilter = append(findItemsFilter, bson.E{"title", primitive.Regex{Pattern: req.Title, Options: "i"}})
-		countItemsFilter = append(countItemsFilter, bson.E{"title", primitive.Regex{Pattern: req.Title, Options: "i"}})
+		countItemsFilter = append(countItemsFilter, bson.E{"title", primitive.Regex{Pattern: regexp.QuoteMeta(req.Title), Options: "i"}})
+	}
+	if req.Category != "" {
+		countItemsFilter = append(countItemsFilter, bson.E{"category", req.Category})
+	}
+	if req.Rarity != "" {
+		countItemsFilter = append(countItemsFilter, bson.E{"rarity", req.Rarity})
+	}
+	if len(req.Tags) > 0 {
+		countItemsFilter = append(countItemsFilter, bson.E{"tags", bson.D{{"$all", req.Tags}}})
+	}
+	now := utils.LocalTime()
+	if req.MinPrice > 0 || req.MaxPrice > 0 {
+		countItemsFilter = append(countItemsFilter, bson.E{"$expr", priceRangeExpr(now, req.MinPrice, req.MaxPrice)})
+	}
+	if req.MinDamage > 0 || req.MaxDamage > 0 {
+		countItemsFilter = append(countItemsFilter, bson.E{"damage", rangeFilter(req.MinDamage, req.MaxDamage)})
+	}
+	for _, attr := range req.Attrs {
+		e, err := attributeFilter(attr)
+		if err != nil {
+			return nil, err
+		}
+		countItemsFilter = append(countItemsFilter, e)
+	}
+
+	// Items a storefront rule sells are only on sale in the player's rotation, so the catalog leaves
+	// them to GET /storefront
+	rules, err := u.itemRepository.FindStorefrontRules(pctx, bson.D{{"usage_status", true}})
+	if err != nil {
+		return nil, err
+	}
+	if managed := storefrontManaged(rules); len(managed) > 0 {
+		managedIds := make([]primitive.ObjectID, 0)
+		for v := range managed {
+			managedIds = append(managedIds, utils.ConvertToObjectId(strings.TrimPrefix(v, "item:")))
+		}
+		countItemsFilter = append(countItemsFilter, bson.E{"$nor", bson.A{bson.D{{"_id", bson.D{{"$in", managedIds}}}}}})
 	}
 
-	findItemsFilter = append(findItemsFilter, bson.E{"usage_status", true})
 	countItemsFilter = append(countItemsFilter, bson.E{"usage_status", true})
+	findItemsFilter = append(findItemsFilter, countItemsFilter...)
+
+	// Sort, prices sort on what checkout charges right now
+	sortField, sortOrder := "_id", 1
+	switch req.Sort {
+	case "price_asc":
+		sortField = "effective_price"
+	case "price_desc":
+		sortField, sortOrder = "effective_price", -1
+	case "damage_asc":
+		sortField = "damage"
+	case "damage_desc":
+		sortField, sortOrder = "damage", -1
+	case "newest":
+		sortField, sortOrder = "created_at", -1
+	}
+
+	// Ranked by text score, paged by offset since the score can't be used as a cursor
+	ranked := req.Q != "" && req.Sort == ""
+	offset := 0
+
+	op := "$gt"
+	if sortOrder < 0 {
+		op = "$lt"
+	}
+	after := bson.D{}
+	if ranked {
+		if req.Start != "" {
+			n, err := strconv.Atoi(req.Start)
+			if err != nil || n < 0 {
+				return nil, errors.New("error: start must be an offset when searching by q")
+			}
+			offset = n
+		}
+	} else if req.Start != "" && req.Limit != 0 {
+		req.Start = strings.TrimPrefix(req.Start, "item:")
+		startId := utils.ConvertToObjectId(req.Start)
+
+		if sortField == "_id" {
+			findItemsFilter = append(findItemsFilter, bson.E{"_id", bson.D{{op, startId}}})
+		} else {
+			start, err := u.itemRepository.FindOneItem(pctx, req.Start)
+			if err != nil {
+				return nil, errors.New("error: start item not found")
+			}
+
+			var startValue any
+			switch sortField {
+			case "effective_price":
+				startValue = item.EffectivePrice(start.Price, start.PriceSchedules, now)
+			case "damage":
+				startValue = start.Damage
+			case "created_at":
+				startValue = start.CreatedAt
+			}
+
+			// The effective price only exists once the search has worked it out, so it is matched afterwards
+			cursor := bson.E{"$or", bson.A{
+				bson.D{{sortField, bson.D{{op, startValue}}}},
+				bson.D{{sortField, startValue}, {"_id", bson.D{{op, startId}}}},
+			}}
+			if sortField == "effective_price" {
+				after = append(after, cursor)
+			} else {
+				findItemsFilter = append(findItemsFilter, cursor)
+			}
+		}
+	}
 
 	//Option
-	findItemOptions = append(findItemOptions, options.Find().SetSort(bson.D{{"_id", 1}}))
+	sort := bson.D{{"_id", sortOrder}}
+	if ranked {
+		sort = bson.D{{"score", bson.M{"$meta": "textScore"}}, {"_id", 1}}
+		findItemOptions = append(findItemOptions, options.Find().SetSkip(int64(offset)))
+	} else if sortField != "_id" {
+		sort = bson.D{{sortField, sortOrder}, {"_id", sortOrder}}
+	}
+	findItemOptions = append(findItemOptions, options.Find().SetSort(sort))
 	findItemOptions = append(findItemOptions, options.Find().SetLimit(int64(req.Limit)))
 
 	//Find
-	result, err := u.itemRepository.FindManyItems(pctx, findItemsFilter, findItemOptions)
+	var result []*item.ItemShowCase
+	if sortField == "effective_price" {
+		result, err = u.itemRepository.FindManyItemsByPrice(pctx, findItemsFilter, after, sortOrder, int64(req.Limit))
+	} else {
+		result, err = u.itemRepository.FindManyItems(pctx, findItemsFilter, findItemOptions)
+	}
 	if err != nil {
 		return nil, errors.New("error: find many items failed")
 	}
@@ -107,37 +301,88 @@ func (u *itemUsecase) FindManyItem(pctx context.Context, basePaginateUrl string,
 		return nil, errors.New("error: count items failed")
 	}
 
+	facets, err := u.itemRepository.FindItemFacets(pctx, countItemsFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	query := itemSearchQuery(req)
+
 	if len(result) == 0 {
-		return &models.PaginateRes{
-			Data:  make([]*item.ItemShowCase, 0),
+		return &item.ItemSearchRes{
+			PaginateRes: models.PaginateRes{
+				Data:  make([]*item.ItemShowCase, 0),
+				Total: total,
+				Limit: req.Limit,
+				First: models.FirstPaginate{
+					Href: fmt.Sprintf("%s?%s", basePaginateUrl, query.Encode()),
+				},
+				Next: models.NextPaginate{
+					Start: "",
+					Href:  "",
+				},
+			},
+			Facets: facets,
+		}, nil
+
+	}
+
+	first := query.Encode()
+	next := result[len(result)-1].ItemId
+	if ranked {
+		next = strconv.Itoa(offset + len(result))
+	}
+	query.Set("start", next)
+
+	return &item.ItemSearchRes{
+		PaginateRes: models.PaginateRes{
+			Data:  result,
 			Total: total,
 			Limit: req.Limit,
 			First: models.FirstPaginate{
-				Href: fmt.Sprintf("%s?limit=%d&title=%s", basePaginateUrl, req.Limit, req.Title),
+				Href: fmt.Sprintf("%s?%s", basePaginateUrl, first),
 			},
 			Next: models.NextPaginate{
-				Start: "",
-				Href:  "",
+				Start: next,
+				Href:  fmt.Sprintf("%s?%s", basePaginateUrl, query.Encode()),
 			},
-		}, nil
+		},
+		Facets: facets,
+	}, nil
+}
 
+func (u *itemUsecase) SuggestItems(pctx context.Context, req *item.ItemSuggestReq) ([]*item.ItemSuggestion, error) {
+	if req.Limit == 0 {
+		req.Limit = 5
 	}
 
-	return &models.PaginateRes{
-		Data:  result,
-		Total: total,
-		Limit: req.Limit,
-		First: models.FirstPaginate{
-			Href: fmt.Sprintf("%s?limit=%d&title=%s", basePaginateUrl, req.Limit, req.Title),
-		},
-		Next: models.NextPaginate{
-			Start: result[len(result)-1].ItemId,
-			Href:  fmt.Sprintf("%s?limit=%d&title=%s&start=%s", basePaginateUrl, req.Limit, req.Title, result[len(result)-1].ItemId),
-		},
-	}, nil
+	// Anchored prefix on the lowercased title so the index can be used
+	filter := bson.D{
+		{"title_lower", primitive.Regex{Pattern: "^" + regexp.QuoteMeta(strings.ToLower(req.Q))}},
+		{"usage_status", true},
+	}
+
+	results, err := u.itemRepository.FindManyItems(pctx, filter, []*options.FindOptions{
+		options.Find().SetSort(bson.D{{"title_lower", 1}}),
+		options.Find().SetLimit(int64(req.Limit)),
+		options.Find().SetProjection(bson.M{"title": 1}),
+	})
+	if err != nil {
+		return nil, errors.New("error: suggest items failed")
+	}
+
+	suggestions := make([]*item.ItemSuggestion, 0)
+	for _, r := range results {
+		suggestions = append(suggestions, &item.ItemSuggestion{
+			ItemId: r.ItemId,
+			Title:  r.Title,
+		})
+	}
+
+	return suggestions, nil
 }
 
-func (u *itemUsecase) EditItem(pctx context.Context, itemId string, req *item.ItemUpdateReq) (*item.ItemShowCase, error) {
+func (u *itemUsecase) EditItem(pctx context.Context, cfg *config.Config, editorId, itemId string, req *item.ItemUpdateReq) (*item.ItemShowCase, error) {
 	// Update logical
 	updateReq := bson.M{}
 	if req.Title != "" {
@@ -147,6 +392,10 @@ func (u *itemUsecase) EditItem(pctx context.Context, itemId string, req *item.It
 		}
 
 		updateReq["title"] = req.Title
+		updateReq["title_lower"] = strings.ToLower(req.Title)
+	}
+	if req.Description != "" {
+		updateReq["description"] = req.Description
 	}
 	if req.ImageUrl != "" {
 		updateReq["image_url"] = req.ImageUrl
@@ -157,25 +406,75 @@ func (u *itemUsecase) EditItem(pctx context.Context, itemId string, req *item.It
 	if req.Price >= 0 {
 		updateReq["price"] = req.Price
 	}
+	if req.Category != "" {
+		updateReq["category"] = req.Category
+	}
+	if req.Rarity != "" {
+		updateReq["rarity"] = req.Rarity
+	}
+	if req.Tags != nil {
+		updateReq["tags"] = req.Tags
+	}
+	if req.Attributes != nil {
+		if err := validateAttributes(req.Attributes); err != nil {
+			return nil, err
+		}
+		updateReq["attributes"] = req.Attributes
+	}
+	if req.InventorySlots > 0 {
+		updateReq["inventory_slots"] = req.InventorySlots
+	}
+	if req.EquipType != "" {
+		updateReq["equip_type"] = req.EquipType
+	}
+	if req.DurationSeconds > 0 {
+		updateReq["duration_seconds"] = req.DurationSeconds
+	}
 	updateReq["updated_at"] = utils.LocalTime()
 
+	before, err := u.itemRepository.FindOneItem(pctx, itemId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Stackable != nil || req.MaxStack > 0 {
+		stackable := before.Stackable
+		if req.Stackable != nil {
+			stackable = *req.Stackable
+		}
+		maxStack := before.MaxStack
+		if req.MaxStack > 0 {
+			maxStack = req.MaxStack
+		}
+		updateReq["stackable"] = stackable
+		updateReq["max_stack"] = item.StackLimit(stackable, maxStack)
+	}
+
 	if err := u.itemRepository.UpdateOneItem(pctx, itemId, updateReq); err != nil {
 		return nil, err
 	}
 
+	u.recordRevision(pctx, cfg, editorId, itemId, "update", before)
+
 	return u.FindOneItem(pctx, itemId)
 }
 
-func (u *itemUsecase) EnableOrDisableItem(pctx context.Context, itemId string) (bool, error) {
+func (u *itemUsecase) EnableOrDisableItem(pctx context.Context, cfg *config.Config, editorId, itemId string) (bool, error) {
 	result, err := u.itemRepository.FindOneItem(pctx, itemId)
 	if err != nil {
 		return false, err
 	}
 
-	if err := u.itemRepository.UpdateOneItem(pctx, itemId, bson.M{"usage_status": !result.UsageStatus}); err != nil {
+	if err := u.itemRepository.UpdateOneItem(pctx, itemId, bson.M{"usage_status": !result.UsageStatus, "updated_at": utils.LocalTime()}); err != nil {
 		return false, err
 	}
 
+	action := "enable"
+	if result.UsageStatus {
+		action = "disable"
+	}
+	u.recordRevision(pctx, cfg, editorId, itemId, action, result)
+
 	return !result.UsageStatus, nil
 }
 
@@ -195,14 +494,50 @@ func (u *itemUsecase) FindItemInIds(pctx context.Context, req *itemPb.FindItemIn
 		return nil, err
 	}
 
+	// Expand bundles so callers get the contained items alongside them
+	found := make(map[string]bool)
+	for _, result := range results {
+		found[result.ItemId] = true
+	}
+	bundleObjectIds := make([]primitive.ObjectID, 0)
+	for _, result := range results {
+		for _, bundleItemId := range result.BundleItems {
+			if !found[bundleItemId] {
+				found[bundleItemId] = true
+				bundleObjectIds = append(bundleObjectIds, utils.ConvertToObjectId(strings.TrimPrefix(bundleItemId, "item:")))
+			}
+		}
+	}
+	if len(bundleObjectIds) > 0 {
+		bundleResults, err := u.itemRepository.FindManyItems(pctx, bson.D{
+			{"_id", bson.D{{"$in", bundleObjectIds}}},
+			{"usage_status", true},
+		}, nil)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, bundleResults...)
+	}
+
 	resultsToRes := make([]*itemPb.Item, 0)
 	for _, result := range results {
 		resultsToRes = append(resultsToRes, &itemPb.Item{
-			Id:       result.ItemId,
-			Title:    result.Title,
-			Price:    result.Price,
-			Damage:   int32(result.Damage),
-			ImageUrl: result.ImageUrl,
+			Id:              result.ItemId,
+			Title:           result.Title,
+			Price:           result.Price,
+			Damage:          int32(result.Damage),
+			ImageUrl:        result.ImageUrl,
+			BundleItems:     result.BundleItems,
+			OriginalPrice:   result.OriginalPrice,
+			Category:        result.Category,
+			Rarity:          result.Rarity,
+			Tags:            result.Tags,
+			Stackable:       result.Stackable,
+			MaxStack:        int32(result.MaxStack),
+			InventorySlots:  int32(result.InventorySlots),
+			EquipType:       result.EquipType,
+			DurationSeconds: result.DurationSeconds,
+			Version:         result.Version,
 		})
 	}
 
@@ -210,3 +545,240 @@ func (u *itemUsecase) FindItemInIds(pctx context.Context, req *itemPb.FindItemIn
 		Items: resultsToRes,
 	}, nil
 }
+
+func (u *itemUsecase) UpdateLootBox(pctx context.Context, cfg *config.Config, editorId, itemId string, req *item.UpdateLootBoxReq) (*item.LootBoxShowCase, error) {
+	before, err := u.itemRepository.FindOneItem(pctx, itemId)
+	if err != nil {
+		return nil, err
+	}
+
+	hasRare := false
+	drops := make([]*item.LootBoxDrop, 0)
+	dropIds := make([]string, 0)
+	for _, v := range req.Drops {
+		dropId := strings.TrimPrefix(v.ItemId, "item:")
+		if dropId == itemId {
+			return nil, errors.New("error: loot box cannot drop itself")
+		}
+		if v.IsRare {
+			hasRare = true
+		}
+
+		drops = append(drops, &item.LootBoxDrop{
+			ItemId: "item:" + dropId,
+			Weight: v.Weight,
+			IsRare: v.IsRare,
+		})
+		dropIds = append(dropIds, dropId)
+	}
+
+	if req.PityThreshold > 0 && !hasRare {
+		return nil, errors.New("error: pity threshold requires at least one rare drop")
+	}
+
+	dropItems, err := u.FindItemInIds(pctx, &itemPb.FindItemInIdsReq{Ids: dropIds})
+	if err != nil {
+		return nil, err
+	}
+	found := make(map[string]bool)
+	for _, v := range dropItems.Items {
+		found[v.Id] = true
+	}
+	for _, v := range drops {
+		if !found[v.ItemId] {
+			log.Printf("Error: UpdateLootBox failed: drop %s not found", v.ItemId)
+			return nil, errors.New("error: drop item not found")
+		}
+	}
+
+	if err := u.itemRepository.UpdateOneItem(pctx, itemId, bson.M{
+		"loot_box": &item.LootBox{
+			DrawCount:     req.DrawCount,
+			PityThreshold: req.PityThreshold,
+			Drops:         drops,
+		},
+		"updated_at": utils.LocalTime(),
+	}); err != nil {
+		return nil, err
+	}
+
+	u.recordRevision(pctx, cfg, editorId, itemId, "update_loot_box", before)
+
+	return u.FindLootBoxDropRates(pctx, itemId)
+}
+
+func (u *itemUsecase) FindLootBoxDropRates(pctx context.Context, itemId string) (*item.LootBoxShowCase, error) {
+	result, err := u.itemRepository.FindOneItem(pctx, itemId)
+	if err != nil {
+		return nil, errors.New("error: find one item not found")
+	}
+
+	if result.LootBox == nil || !result.UsageStatus {
+		return nil, errors.New("error: item is not a loot box")
+	}
+
+	objectIds := make([]primitive.ObjectID, 0)
+	totalWeight := 0
+	for _, v := range result.LootBox.Drops {
+		objectIds = append(objectIds, utils.ConvertToObjectId(strings.TrimPrefix(v.ItemId, "item:")))
+		totalWeight += v.Weight
+	}
+
+	dropItems, err := u.itemRepository.FindManyItems(pctx, bson.D{{"_id", bson.D{{"$in", objectIds}}}}, nil)
+	if err != nil {
+		return nil, err
+	}
+	titles := make(map[string]string)
+	for _, v := range dropItems {
+		titles[v.ItemId] = v.Title
+	}
+
+	drops := make([]*item.LootBoxDropRate, 0)
+	for _, v := range result.LootBox.Drops {
+		drops = append(drops, &item.LootBoxDropRate{
+			ItemId: v.ItemId,
+			Title:  titles[v.ItemId],
+			Weight: v.Weight,
+			Rate:   float64(v.Weight) / float64(totalWeight) * 100,
+			IsRare: v.IsRare,
+		})
+	}
+
+	return &item.LootBoxShowCase{
+		ItemId:        "item:" + result.Id.Hex(),
+		Title:         result.Title,
+		DrawCount:     result.LootBox.DrawCount,
+		PityThreshold: result.LootBox.PityThreshold,
+		Drops:         drops,
+	}, nil
+}
+
+func (u *itemUsecase) FindOneLootBox(pctx context.Context, req *itemPb.FindOneLootBoxReq) (*itemPb.LootBox, error) {
+	result, err := u.itemRepository.FindOneItem(pctx, strings.TrimPrefix(req.Id, "item:"))
+	if err != nil {
+		return nil, err
+	}
+
+	if result.LootBox == nil || !result.UsageStatus {
+		return nil, errors.New("error: item is not a loot box")
+	}
+
+	drops := make([]*itemPb.LootBoxDrop, 0)
+	for _, v := range result.LootBox.Drops {
+		drops = append(drops, &itemPb.LootBoxDrop{
+			ItemId: v.ItemId,
+			Weight: int32(v.Weight),
+			IsRare: v.IsRare,
+		})
+	}
+
+	return &itemPb.LootBox{
+		Id:            "item:" + result.Id.Hex(),
+		Title:         result.Title,
+		DrawCount:     int32(result.LootBox.DrawCount),
+		PityThreshold: int32(result.LootBox.PityThreshold),
+		Drops:         drops,
+	}, nil
+}
+
+func (u *itemUsecase) validateBundleItems(pctx context.Context, bundleItems []string) ([]string, error) {
+	if len(bundleItems) == 0 {
+		return nil, nil
+	}
+
+	objectIds := make([]primitive.ObjectID, 0)
+	results := make([]string, 0)
+	for _, bundleItemId := range bundleItems {
+		bundleItemId = strings.TrimPrefix(bundleItemId, "item:")
+		objectIds = append(objectIds, utils.ConvertToObjectId(bundleItemId))
+		results = append(results, "item:"+bundleItemId)
+	}
+
+	contents, err := u.itemRepository.FindManyItems(pctx, bson.D{
+		{"_id", bson.D{{"$in", objectIds}}},
+		{"usage_status", true},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	contentMaps := make(map[string]*item.ItemShowCase)
+	for _, v := range contents {
+		contentMaps[v.ItemId] = v
+	}
+	for _, bundleItemId := range results {
+		content, ok := contentMaps[bundleItemId]
+		if !ok {
+			log.Printf("Error: validateBundleItems failed: %s not found", bundleItemId)
+			return nil, errors.New("error: bundle item not found")
+		}
+		if len(content.BundleItems) > 0 {
+			return nil, errors.New("error: bundle cannot contain another bundle")
+		}
+	}
+
+	return results, nil
+}
+
+func (u *itemUsecase) CreatePriceSchedule(pctx context.Context, cfg *config.Config, editorId, itemId string, req *item.CreatePriceScheduleReq) ([]*item.PriceSchedule, error) {
+	result, err := u.itemRepository.FindOneItem(pctx, itemId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Type == "percent" && (req.Value <= 0 || req.Value >= 100) {
+		return nil, errors.New("error: percent must be between 0 and 100")
+	}
+	if req.EndAt.Before(utils.LocalTime()) {
+		return nil, errors.New("error: price schedule already ended")
+	}
+
+	for _, v := range result.PriceSchedules {
+		if req.StartAt.Before(v.EndAt) && v.StartAt.Before(req.EndAt) {
+			log.Printf("Error: CreatePriceSchedule failed: overlaps %s", v.ScheduleId)
+			return nil, errors.New("error: price schedule overlaps an existing one")
+		}
+	}
+
+	if err := u.itemRepository.PushPriceSchedule(pctx, itemId, &item.PriceSchedule{
+		ScheduleId: primitive.NewObjectID().Hex(),
+		Type:       req.Type,
+		Value:      req.Value,
+		StartAt:    req.StartAt,
+		EndAt:      req.EndAt,
+	}); err != nil {
+		return nil, err
+	}
+
+	u.recordRevision(pctx, cfg, editorId, itemId, "create_price_schedule", result)
+
+	return u.FindPriceSchedules(pctx, itemId)
+}
+
+func (u *itemUsecase) FindPriceSchedules(pctx context.Context, itemId string) ([]*item.PriceSchedule, error) {
+	result, err := u.itemRepository.FindOneItem(pctx, itemId)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.PriceSchedules == nil {
+		return make([]*item.PriceSchedule, 0), nil
+	}
+
+	return result.PriceSchedules, nil
+}
+
+func (u *itemUsecase) DeletePriceSchedule(pctx context.Context, cfg *config.Config, editorId, itemId, scheduleId string) ([]*item.PriceSchedule, error) {
+	before, err := u.itemRepository.FindOneItem(pctx, itemId)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.itemRepository.PullPriceSchedule(pctx, itemId, scheduleId); err != nil {
+		return nil, err
+	}
+
+	u.recordRevision(pctx, cfg, editorId, itemId, "delete_price_schedule", before)
+
+	return u.FindPriceSchedules(pctx, itemId)
+}
@@ -13,7 +13,9 @@ import (
 type (
 	MiddlewareHandlerService interface {
 		JwtAuthorization(next echo.HandlerFunc) echo.HandlerFunc
+		OptionalJwtAuthorization(next echo.HandlerFunc) echo.HandlerFunc
 		RbacAuthorization(next echo.HandlerFunc, expected []int) echo.HandlerFunc
+		PlayerOrRbacAuthorization(next, otherwise echo.HandlerFunc, expected []int) echo.HandlerFunc
 		PlayerIdParamValidation(next echo.HandlerFunc) echo.HandlerFunc
 	}
 
@@ -41,6 +43,18 @@ func (h *middlewareHandler) JwtAuthorization(next echo.HandlerFunc) echo.Handler
 
 }
 
+// OptionalJwtAuthorization lets a request without a token through anonymously, a token that is
+// sent still has to be valid
+func (h *middlewareHandler) OptionalJwtAuthorization(next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if c.Request().Header.Get("Authorization") == "" {
+			return next(c)
+		}
+
+		return h.JwtAuthorization(next)(c)
+	}
+}
+
 func (h *middlewareHandler) RbacAuthorization(next echo.HandlerFunc, expected []int) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		newCtx, err := h.middlewareUsecase.RbacAuthorization(c, h.cfg, expected)
@@ -53,6 +67,25 @@ func (h *middlewareHandler) RbacAuthorization(next echo.HandlerFunc, expected []
 
 }
 
+// PlayerOrRbacAuthorization sends the player named in the path and the expected roles to next,
+// anyone else, anonymous callers included, goes to otherwise
+func (h *middlewareHandler) PlayerOrRbacAuthorization(next, otherwise echo.HandlerFunc, expected []int) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if _, ok := c.Get("player_id").(string); !ok {
+			return otherwise(c)
+		}
+
+		if newCtx, err := h.middlewareUsecase.PlayerIdParamValidation(c); err == nil {
+			return next(newCtx)
+		}
+		if newCtx, err := h.middlewareUsecase.RbacAuthorization(c, h.cfg, expected); err == nil {
+			return next(newCtx)
+		}
+
+		return otherwise(c)
+	}
+}
+
 func (h *middlewareHandler) PlayerIdParamValidation(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		newCtx, err := h.middlewareUsecase.PlayerIdParamValidation(c)
@@ -3,6 +3,7 @@ package middlewareUsecase
 import (
 	"errors"
 	"log"
+	"strings"
 
 	"github.com/Applessr/hello-sekai-shop-tutorial/config"
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/middleware/middlewareRepository"
@@ -75,7 +76,7 @@ func (u *middlewareUsecase) PlayerIdParamValidation(c echo.Context) (echo.Contex
 		return nil, errors.New("error: player id not found")
 	}
 
-	if playerIdReq != playerIdToken {
+	if strings.TrimPrefix(playerIdReq, "player:") != strings.TrimPrefix(playerIdToken, "player:") {
 		log.Printf("Error: player id not match")
 		return nil, errors.New("error: player id not match")
 	}
@@ -7,11 +7,12 @@ type (
 	}
 
 	PaginateRes struct {
-		Data  any           `json:"data"`
-		Limit int           `json:"limit"`
-		Total int64         `json:"total"`
-		First FirstPaginate `json:"first"`
-		Next  NextPaginate  `json:"next"`
+		Data     any           `json:"data"`
+		Limit    int           `json:"limit"`
+		Total    int64         `json:"total"`
+		First    FirstPaginate `json:"first"`
+		Next     NextPaginate  `json:"next"`
+		Degraded bool          `json:"degraded,omitempty"`
 	}
 
 	FirstPaginate struct {
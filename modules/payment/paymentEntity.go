@@ -0,0 +1,65 @@
+package payment
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type (
+	LootBoxSeed struct {
+		Id             primitive.ObjectID `json:"_id" bson:"_id,omitempty"`
+		PlayerId       string             `json:"player_id" bson:"player_id"`
+		ServerSeed     string             `json:"server_seed" bson:"server_seed"`
+		ServerSeedHash string             `json:"server_seed_hash" bson:"server_seed_hash"`
+		Nonce          int64              `json:"nonce" bson:"nonce"`
+		IsActive       bool               `json:"is_active" bson:"is_active"`
+		CreatedAt      time.Time          `json:"created_at" bson:"created_at"`
+		RevealedAt     time.Time          `json:"revealed_at" bson:"revealed_at"`
+	}
+
+	LootBoxPity struct {
+		PlayerId  string `json:"player_id" bson:"player_id"`
+		LootBoxId string `json:"loot_box_id" bson:"loot_box_id"`
+		Count     int    `json:"count" bson:"count"`
+	}
+
+	LootBoxDraw struct {
+		Id             primitive.ObjectID `json:"_id" bson:"_id,omitempty"`
+		PlayerId       string             `json:"player_id" bson:"player_id"`
+		LootBoxId      string             `json:"loot_box_id" bson:"loot_box_id"`
+		ItemId         string             `json:"item_id" bson:"item_id"`
+		InventoryId    string             `json:"inventory_id" bson:"inventory_id"`
+		ServerSeedHash string             `json:"server_seed_hash" bson:"server_seed_hash"`
+		ClientSeed     string             `json:"client_seed" bson:"client_seed"`
+		Nonce          int64              `json:"nonce" bson:"nonce"`
+		Roll           float64            `json:"roll" bson:"roll"`
+		IsPity         bool               `json:"is_pity" bson:"is_pity"`
+		CreatedAt      time.Time          `json:"created_at" bson:"created_at"`
+	}
+
+	Order struct {
+		Id        primitive.ObjectID `json:"_id" bson:"_id,omitempty"`
+		PlayerId  string             `json:"player_id" bson:"player_id"`
+		RequestId string             `json:"request_id,omitempty" bson:"request_id,omitempty"`
+		Type      string             `json:"type" bson:"type"`
+		Status    string             `json:"status" bson:"status"`
+		Source    string             `json:"source" bson:"source"`
+		Reason    string             `json:"reason,omitempty" bson:"reason,omitempty"`
+		Items     []*OrderItem       `json:"items" bson:"items"`
+		Grants    []*OrderGrant      `json:"grants,omitempty" bson:"grants,omitempty"`
+		Total     float64            `json:"total" bson:"total"`
+		Error     string             `json:"error,omitempty" bson:"error,omitempty"`
+		CreatedAt time.Time          `json:"created_at" bson:"created_at"`
+	}
+
+	OrderItem struct {
+		ItemId string  `json:"item_id" bson:"item_id"`
+		Price  float64 `json:"price" bson:"price"`
+	}
+
+	OrderGrant struct {
+		ItemId      string `json:"item_id" bson:"item_id"`
+		InventoryId string `json:"inventory_id" bson:"inventory_id"`
+	}
+)
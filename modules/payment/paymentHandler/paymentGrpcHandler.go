@@ -0,0 +1,40 @@
+package paymentHandler
+
+import (
+	"context"
+
+	"github.com/Applessr/hello-sekai-shop-tutorial/config"
+	paymentPb "github.com/Applessr/hello-sekai-shop-tutorial/modules/payment/paymentPb"
+	"github.com/Applessr/hello-sekai-shop-tutorial/modules/payment/paymentUsecase"
+)
+
+type (
+	paymentGrpcHandler struct {
+		paymentPb.UnimplementedPaymentGrpcServiceServer
+		cfg            *config.Config
+		paymentUsecase paymentUsecase.PaymentUsecaseService
+	}
+)
+
+func NewPaymentGrpcHandler(cfg *config.Config, paymentUsecase paymentUsecase.PaymentUsecaseService) *paymentGrpcHandler {
+	return &paymentGrpcHandler{
+		cfg:            cfg,
+		paymentUsecase: paymentUsecase,
+	}
+}
+
+func (g *paymentGrpcHandler) BuyItems(ctx context.Context, req *paymentPb.BuyItemsReq) (*paymentPb.PaymentOrder, error) {
+	return g.paymentUsecase.BuyItems(ctx, g.cfg, req)
+}
+
+func (g *paymentGrpcHandler) SellItems(ctx context.Context, req *paymentPb.SellItemsReq) (*paymentPb.PaymentOrder, error) {
+	return g.paymentUsecase.SellItems(ctx, g.cfg, req)
+}
+
+func (g *paymentGrpcHandler) GetOrder(ctx context.Context, req *paymentPb.GetOrderReq) (*paymentPb.PaymentOrder, error) {
+	return g.paymentUsecase.GetOrder(ctx, req)
+}
+
+func (g *paymentGrpcHandler) ListOrders(ctx context.Context, req *paymentPb.ListOrdersReq) (*paymentPb.ListOrdersRes, error) {
+	return g.paymentUsecase.ListOrders(ctx, req)
+}
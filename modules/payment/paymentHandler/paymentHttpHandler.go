@@ -15,7 +15,11 @@ import (
 type (
 	PaymentHttpHandlerService interface {
 		BuyItem(c echo.Context) error
+		QuoteItems(c echo.Context) error
 		SellItem(c echo.Context) error
+		GetLootBoxSeed(c echo.Context) error
+		RotateLootBoxSeed(c echo.Context) error
+		OpenLootBox(c echo.Context) error
 	}
 
 	paymentHttpHandler struct {
@@ -51,6 +55,27 @@ func (h *paymentHttpHandler) BuyItem(c echo.Context) error {
 	return response.SuccessResponse(c, http.StatusOK, res)
 }
 
+func (h *paymentHttpHandler) QuoteItems(c echo.Context) error {
+	ctx := context.Background()
+
+	wrapper := request.ContextWrapper(c)
+
+	req := &payment.ItemServiceReq{
+		Items: make([]*payment.ItemServiceReqDatum, 0),
+	}
+
+	if err := wrapper.Bind(req); err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	res, err := h.paymentUsecase.QuoteItems(ctx, h.cfg, req)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, res)
+}
+
 func (h *paymentHttpHandler) SellItem(c echo.Context) error {
 	ctx := context.Background()
 
@@ -73,3 +98,50 @@ func (h *paymentHttpHandler) SellItem(c echo.Context) error {
 
 	return response.SuccessResponse(c, http.StatusOK, res)
 }
+
+func (h *paymentHttpHandler) GetLootBoxSeed(c echo.Context) error {
+	ctx := context.Background()
+
+	playerId := c.Get("player_id").(string)
+
+	res, err := h.paymentUsecase.GetLootBoxSeed(ctx, playerId)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, res)
+}
+
+func (h *paymentHttpHandler) RotateLootBoxSeed(c echo.Context) error {
+	ctx := context.Background()
+
+	playerId := c.Get("player_id").(string)
+
+	res, err := h.paymentUsecase.RotateLootBoxSeed(ctx, playerId)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, res)
+}
+
+func (h *paymentHttpHandler) OpenLootBox(c echo.Context) error {
+	ctx := context.Background()
+
+	wrapper := request.ContextWrapper(c)
+
+	playerId := c.Get("player_id").(string)
+
+	req := new(payment.OpenLootBoxReq)
+
+	if err := wrapper.Bind(req); err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	res, err := h.paymentUsecase.OpenLootBox(ctx, h.cfg, playerId, req)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, res)
+}
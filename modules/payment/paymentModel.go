@@ -2,12 +2,26 @@ package payment
 
 type (
 	ItemServiceReq struct {
-		Items []*ItemServiceReqDatum `json:"items" validate:"required"`
+		Items        []*ItemServiceReqDatum `json:"items" validate:"required"`
+		PriceVersion string                 `json:"price_version" validate:"max=64"`
+	}
+
+	QuoteRes struct {
+		Items        []*QuoteItem `json:"items"`
+		Total        float64      `json:"total"`
+		PriceVersion string       `json:"price_version"`
+	}
+
+	QuoteItem struct {
+		ItemId        string  `json:"item_id"`
+		Price         float64 `json:"price"`
+		OriginalPrice float64 `json:"original_price,omitempty"`
 	}
 
 	ItemServiceReqDatum struct {
-		ItemId string  `json:"item_id" validate:"required,max=64"`
-		Price  float64 `json:"price"`
+		ItemId      string   `json:"item_id" validate:"required,max=64"`
+		Price       float64  `json:"price"`
+		BundleItems []string `json:"-"`
 	}
 
 	PaymentTransferReq struct {
@@ -24,4 +38,26 @@ type (
 		Amount        float64 `json:"amount"`
 		Error         string  `json:"error"`
 	}
+
+	OpenLootBoxReq struct {
+		LootBoxId  string `json:"loot_box_id" validate:"required,max=64"`
+		ClientSeed string `json:"client_seed" validate:"required,max=64"`
+	}
+
+	LootBoxSeedRes struct {
+		ServerSeedHash         string `json:"server_seed_hash"`
+		Nonce                  int64  `json:"nonce"`
+		PreviousServerSeed     string `json:"previous_server_seed,omitempty"`
+		PreviousServerSeedHash string `json:"previous_server_seed_hash,omitempty"`
+	}
+
+	LootBoxDrawRes struct {
+		ItemId         string  `json:"item_id"`
+		InventoryId    string  `json:"inventory_id"`
+		ServerSeedHash string  `json:"server_seed_hash"`
+		ClientSeed     string  `json:"client_seed"`
+		Nonce          int64   `json:"nonce"`
+		Roll           float64 `json:"roll"`
+		IsPity         bool    `json:"is_pity"`
+	}
 )
@@ -0,0 +1,841 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v4.25.3
+// source: modules/payment/paymentPb/paymentPb.proto
+
+package hello_sekai_shop_tutorial
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PaymentOrderItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemId string  `protobuf:"bytes,1,opt,name=itemId,proto3" json:"itemId,omitempty"`
+	Price  float64 `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (x *PaymentOrderItem) Reset() {
+	*x = PaymentOrderItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_payment_paymentPb_paymentPb_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaymentOrderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentOrderItem) ProtoMessage() {}
+
+func (x *PaymentOrderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_payment_paymentPb_paymentPb_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentOrderItem.ProtoReflect.Descriptor instead.
+func (*PaymentOrderItem) Descriptor() ([]byte, []int) {
+	return file_modules_payment_paymentPb_paymentPb_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PaymentOrderItem) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *PaymentOrderItem) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+type PaymentOrderGrant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ItemId      string `protobuf:"bytes,1,opt,name=itemId,proto3" json:"itemId,omitempty"`
+	InventoryId string `protobuf:"bytes,2,opt,name=inventoryId,proto3" json:"inventoryId,omitempty"`
+}
+
+func (x *PaymentOrderGrant) Reset() {
+	*x = PaymentOrderGrant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_payment_paymentPb_paymentPb_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaymentOrderGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentOrderGrant) ProtoMessage() {}
+
+func (x *PaymentOrderGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_payment_paymentPb_paymentPb_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentOrderGrant.ProtoReflect.Descriptor instead.
+func (*PaymentOrderGrant) Descriptor() ([]byte, []int) {
+	return file_modules_payment_paymentPb_paymentPb_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PaymentOrderGrant) GetItemId() string {
+	if x != nil {
+		return x.ItemId
+	}
+	return ""
+}
+
+func (x *PaymentOrderGrant) GetInventoryId() string {
+	if x != nil {
+		return x.InventoryId
+	}
+	return ""
+}
+
+type PaymentOrder struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	PlayerId  string               `protobuf:"bytes,2,opt,name=playerId,proto3" json:"playerId,omitempty"`
+	Type      string               `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Status    string               `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Source    string               `protobuf:"bytes,5,opt,name=source,proto3" json:"source,omitempty"`
+	Reason    string               `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Items     []*PaymentOrderItem  `protobuf:"bytes,7,rep,name=items,proto3" json:"items,omitempty"`
+	Grants    []*PaymentOrderGrant `protobuf:"bytes,8,rep,name=grants,proto3" json:"grants,omitempty"`
+	Total     float64              `protobuf:"fixed64,9,opt,name=total,proto3" json:"total,omitempty"`
+	Error     string               `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt string               `protobuf:"bytes,11,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	RequestId string               `protobuf:"bytes,12,opt,name=requestId,proto3" json:"requestId,omitempty"`
+}
+
+func (x *PaymentOrder) Reset() {
+	*x = PaymentOrder{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_payment_paymentPb_paymentPb_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PaymentOrder) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PaymentOrder) ProtoMessage() {}
+
+func (x *PaymentOrder) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_payment_paymentPb_paymentPb_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PaymentOrder.ProtoReflect.Descriptor instead.
+func (*PaymentOrder) Descriptor() ([]byte, []int) {
+	return file_modules_payment_paymentPb_paymentPb_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PaymentOrder) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PaymentOrder) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *PaymentOrder) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PaymentOrder) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PaymentOrder) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *PaymentOrder) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *PaymentOrder) GetItems() []*PaymentOrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *PaymentOrder) GetGrants() []*PaymentOrderGrant {
+	if x != nil {
+		return x.Grants
+	}
+	return nil
+}
+
+func (x *PaymentOrder) GetTotal() float64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PaymentOrder) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *PaymentOrder) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *PaymentOrder) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type BuyItemsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId     string   `protobuf:"bytes,1,opt,name=playerId,proto3" json:"playerId,omitempty"`
+	ItemIds      []string `protobuf:"bytes,2,rep,name=itemIds,proto3" json:"itemIds,omitempty"`
+	PriceVersion string   `protobuf:"bytes,3,opt,name=priceVersion,proto3" json:"priceVersion,omitempty"`
+	Reason       string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	RequestId    string   `protobuf:"bytes,5,opt,name=requestId,proto3" json:"requestId,omitempty"`
+}
+
+func (x *BuyItemsReq) Reset() {
+	*x = BuyItemsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_payment_paymentPb_paymentPb_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuyItemsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuyItemsReq) ProtoMessage() {}
+
+func (x *BuyItemsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_payment_paymentPb_paymentPb_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuyItemsReq.ProtoReflect.Descriptor instead.
+func (*BuyItemsReq) Descriptor() ([]byte, []int) {
+	return file_modules_payment_paymentPb_paymentPb_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BuyItemsReq) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *BuyItemsReq) GetItemIds() []string {
+	if x != nil {
+		return x.ItemIds
+	}
+	return nil
+}
+
+func (x *BuyItemsReq) GetPriceVersion() string {
+	if x != nil {
+		return x.PriceVersion
+	}
+	return ""
+}
+
+func (x *BuyItemsReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *BuyItemsReq) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type SellItemsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId  string   `protobuf:"bytes,1,opt,name=playerId,proto3" json:"playerId,omitempty"`
+	ItemIds   []string `protobuf:"bytes,2,rep,name=itemIds,proto3" json:"itemIds,omitempty"`
+	Reason    string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	RequestId string   `protobuf:"bytes,4,opt,name=requestId,proto3" json:"requestId,omitempty"`
+}
+
+func (x *SellItemsReq) Reset() {
+	*x = SellItemsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_payment_paymentPb_paymentPb_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SellItemsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SellItemsReq) ProtoMessage() {}
+
+func (x *SellItemsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_payment_paymentPb_paymentPb_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SellItemsReq.ProtoReflect.Descriptor instead.
+func (*SellItemsReq) Descriptor() ([]byte, []int) {
+	return file_modules_payment_paymentPb_paymentPb_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SellItemsReq) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *SellItemsReq) GetItemIds() []string {
+	if x != nil {
+		return x.ItemIds
+	}
+	return nil
+}
+
+func (x *SellItemsReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SellItemsReq) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+type GetOrderReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderId string `protobuf:"bytes,1,opt,name=orderId,proto3" json:"orderId,omitempty"`
+}
+
+func (x *GetOrderReq) Reset() {
+	*x = GetOrderReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_payment_paymentPb_paymentPb_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetOrderReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderReq) ProtoMessage() {}
+
+func (x *GetOrderReq) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_payment_paymentPb_paymentPb_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrderReq.ProtoReflect.Descriptor instead.
+func (*GetOrderReq) Descriptor() ([]byte, []int) {
+	return file_modules_payment_paymentPb_paymentPb_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetOrderReq) GetOrderId() string {
+	if x != nil {
+		return x.OrderId
+	}
+	return ""
+}
+
+type ListOrdersReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlayerId string `protobuf:"bytes,1,opt,name=playerId,proto3" json:"playerId,omitempty"`
+	Type     string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Start    string `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	Limit    int32  `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListOrdersReq) Reset() {
+	*x = ListOrdersReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_payment_paymentPb_paymentPb_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOrdersReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersReq) ProtoMessage() {}
+
+func (x *ListOrdersReq) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_payment_paymentPb_paymentPb_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersReq.ProtoReflect.Descriptor instead.
+func (*ListOrdersReq) Descriptor() ([]byte, []int) {
+	return file_modules_payment_paymentPb_paymentPb_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListOrdersReq) GetPlayerId() string {
+	if x != nil {
+		return x.PlayerId
+	}
+	return ""
+}
+
+func (x *ListOrdersReq) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *ListOrdersReq) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *ListOrdersReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListOrdersRes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Orders    []*PaymentOrder `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	NextStart string          `protobuf:"bytes,2,opt,name=nextStart,proto3" json:"nextStart,omitempty"`
+}
+
+func (x *ListOrdersRes) Reset() {
+	*x = ListOrdersRes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_modules_payment_paymentPb_paymentPb_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListOrdersRes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListOrdersRes) ProtoMessage() {}
+
+func (x *ListOrdersRes) ProtoReflect() protoreflect.Message {
+	mi := &file_modules_payment_paymentPb_paymentPb_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListOrdersRes.ProtoReflect.Descriptor instead.
+func (*ListOrdersRes) Descriptor() ([]byte, []int) {
+	return file_modules_payment_paymentPb_paymentPb_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListOrdersRes) GetOrders() []*PaymentOrder {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+func (x *ListOrdersRes) GetNextStart() string {
+	if x != nil {
+		return x.NextStart
+	}
+	return ""
+}
+
+var File_modules_payment_paymentPb_paymentPb_proto protoreflect.FileDescriptor
+
+var file_modules_payment_paymentPb_paymentPb_proto_rawDesc = []byte{
+	0x0a, 0x29, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x73, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x62, 0x2f, 0x70, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x50, 0x62, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x40, 0x0a, 0x10, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x12,
+	0x16, 0x0a, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x4d, 0x0a,
+	0x11, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6e,
+	0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x69, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x6f, 0x72, 0x79, 0x49, 0x64, 0x22, 0xd3, 0x02, 0x0a,
+	0x0c, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x72,
+	0x64, 0x65, 0x72, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x2a,
+	0x0a, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x47, 0x72, 0x61,
+	0x6e, 0x74, 0x52, 0x06, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x64, 0x22, 0x9d, 0x01, 0x0a, 0x0b, 0x42, 0x75, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x64, 0x22, 0x7a, 0x0a, 0x0c, 0x53, 0x65, 0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52,
+	0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x69, 0x74, 0x65, 0x6d, 0x49, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x27,
+	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a,
+	0x07, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6f, 0x72, 0x64, 0x65, 0x72, 0x49, 0x64, 0x22, 0x6b, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x22, 0x54, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x73, 0x12, 0x25, 0x0a, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4f,
+	0x72, 0x64, 0x65, 0x72, 0x52, 0x06, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x6e, 0x65, 0x78, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6e, 0x65, 0x78, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x32, 0xbf, 0x01, 0x0a, 0x12, 0x50,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x70, 0x63, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x27, 0x0a, 0x08, 0x42, 0x75, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x0c, 0x2e,
+	0x42, 0x75, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x50, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x09, 0x53, 0x65,
+	0x6c, 0x6c, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x0d, 0x2e, 0x53, 0x65, 0x6c, 0x6c, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0d, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65,
+	0x72, 0x12, 0x0c, 0x2e, 0x47, 0x65, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x1a,
+	0x0d, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x2c,
+	0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x12, 0x0e, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x42, 0x2f, 0x5a, 0x2d,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x41, 0x70, 0x70, 0x6c, 0x65,
+	0x73, 0x73, 0x72, 0x2f, 0x68, 0x65, 0x6c, 0x6c, 0x6f, 0x2d, 0x73, 0x65, 0x6b, 0x61, 0x69, 0x2d,
+	0x73, 0x68, 0x6f, 0x70, 0x2d, 0x74, 0x75, 0x74, 0x6f, 0x72, 0x69, 0x61, 0x6c, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_modules_payment_paymentPb_paymentPb_proto_rawDescOnce sync.Once
+	file_modules_payment_paymentPb_paymentPb_proto_rawDescData = file_modules_payment_paymentPb_paymentPb_proto_rawDesc
+)
+
+func file_modules_payment_paymentPb_paymentPb_proto_rawDescGZIP() []byte {
+	file_modules_payment_paymentPb_paymentPb_proto_rawDescOnce.Do(func() {
+		file_modules_payment_paymentPb_paymentPb_proto_rawDescData = protoimpl.X.CompressGZIP(file_modules_payment_paymentPb_paymentPb_proto_rawDescData)
+	})
+	return file_modules_payment_paymentPb_paymentPb_proto_rawDescData
+}
+
+var file_modules_payment_paymentPb_paymentPb_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_modules_payment_paymentPb_paymentPb_proto_goTypes = []interface{}{
+	(*PaymentOrderItem)(nil),  // 0: PaymentOrderItem
+	(*PaymentOrderGrant)(nil), // 1: PaymentOrderGrant
+	(*PaymentOrder)(nil),      // 2: PaymentOrder
+	(*BuyItemsReq)(nil),       // 3: BuyItemsReq
+	(*SellItemsReq)(nil),      // 4: SellItemsReq
+	(*GetOrderReq)(nil),       // 5: GetOrderReq
+	(*ListOrdersReq)(nil),     // 6: ListOrdersReq
+	(*ListOrdersRes)(nil),     // 7: ListOrdersRes
+}
+var file_modules_payment_paymentPb_paymentPb_proto_depIdxs = []int32{
+	0, // 0: PaymentOrder.items:type_name -> PaymentOrderItem
+	1, // 1: PaymentOrder.grants:type_name -> PaymentOrderGrant
+	2, // 2: ListOrdersRes.orders:type_name -> PaymentOrder
+	3, // 3: PaymentGrpcService.BuyItems:input_type -> BuyItemsReq
+	4, // 4: PaymentGrpcService.SellItems:input_type -> SellItemsReq
+	5, // 5: PaymentGrpcService.GetOrder:input_type -> GetOrderReq
+	6, // 6: PaymentGrpcService.ListOrders:input_type -> ListOrdersReq
+	2, // 7: PaymentGrpcService.BuyItems:output_type -> PaymentOrder
+	2, // 8: PaymentGrpcService.SellItems:output_type -> PaymentOrder
+	2, // 9: PaymentGrpcService.GetOrder:output_type -> PaymentOrder
+	7, // 10: PaymentGrpcService.ListOrders:output_type -> ListOrdersRes
+	7, // [7:11] is the sub-list for method output_type
+	3, // [3:7] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_modules_payment_paymentPb_paymentPb_proto_init() }
+func file_modules_payment_paymentPb_paymentPb_proto_init() {
+	if File_modules_payment_paymentPb_paymentPb_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_modules_payment_paymentPb_paymentPb_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaymentOrderItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_payment_paymentPb_paymentPb_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaymentOrderGrant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_payment_paymentPb_paymentPb_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaymentOrder); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_payment_paymentPb_paymentPb_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuyItemsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_payment_paymentPb_paymentPb_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SellItemsReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_payment_paymentPb_paymentPb_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetOrderReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_payment_paymentPb_paymentPb_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOrdersReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_modules_payment_paymentPb_paymentPb_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListOrdersRes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_modules_payment_paymentPb_paymentPb_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_modules_payment_paymentPb_paymentPb_proto_goTypes,
+		DependencyIndexes: file_modules_payment_paymentPb_paymentPb_proto_depIdxs,
+		MessageInfos:      file_modules_payment_paymentPb_paymentPb_proto_msgTypes,
+	}.Build()
+	File_modules_payment_paymentPb_paymentPb_proto = out.File
+	file_modules_payment_paymentPb_paymentPb_proto_rawDesc = nil
+	file_modules_payment_paymentPb_paymentPb_proto_goTypes = nil
+	file_modules_payment_paymentPb_paymentPb_proto_depIdxs = nil
+}
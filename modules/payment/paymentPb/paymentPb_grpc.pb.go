@@ -0,0 +1,213 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v4.25.3
+// source: modules/payment/paymentPb/paymentPb.proto
+
+package hello_sekai_shop_tutorial
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// PaymentGrpcServiceClient is the client API for PaymentGrpcService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PaymentGrpcServiceClient interface {
+	BuyItems(ctx context.Context, in *BuyItemsReq, opts ...grpc.CallOption) (*PaymentOrder, error)
+	SellItems(ctx context.Context, in *SellItemsReq, opts ...grpc.CallOption) (*PaymentOrder, error)
+	GetOrder(ctx context.Context, in *GetOrderReq, opts ...grpc.CallOption) (*PaymentOrder, error)
+	ListOrders(ctx context.Context, in *ListOrdersReq, opts ...grpc.CallOption) (*ListOrdersRes, error)
+}
+
+type paymentGrpcServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPaymentGrpcServiceClient(cc grpc.ClientConnInterface) PaymentGrpcServiceClient {
+	return &paymentGrpcServiceClient{cc}
+}
+
+func (c *paymentGrpcServiceClient) BuyItems(ctx context.Context, in *BuyItemsReq, opts ...grpc.CallOption) (*PaymentOrder, error) {
+	out := new(PaymentOrder)
+	err := c.cc.Invoke(ctx, "/PaymentGrpcService/BuyItems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentGrpcServiceClient) SellItems(ctx context.Context, in *SellItemsReq, opts ...grpc.CallOption) (*PaymentOrder, error) {
+	out := new(PaymentOrder)
+	err := c.cc.Invoke(ctx, "/PaymentGrpcService/SellItems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentGrpcServiceClient) GetOrder(ctx context.Context, in *GetOrderReq, opts ...grpc.CallOption) (*PaymentOrder, error) {
+	out := new(PaymentOrder)
+	err := c.cc.Invoke(ctx, "/PaymentGrpcService/GetOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentGrpcServiceClient) ListOrders(ctx context.Context, in *ListOrdersReq, opts ...grpc.CallOption) (*ListOrdersRes, error) {
+	out := new(ListOrdersRes)
+	err := c.cc.Invoke(ctx, "/PaymentGrpcService/ListOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PaymentGrpcServiceServer is the server API for PaymentGrpcService service.
+// All implementations must embed UnimplementedPaymentGrpcServiceServer
+// for forward compatibility
+type PaymentGrpcServiceServer interface {
+	BuyItems(context.Context, *BuyItemsReq) (*PaymentOrder, error)
+	SellItems(context.Context, *SellItemsReq) (*PaymentOrder, error)
+	GetOrder(context.Context, *GetOrderReq) (*PaymentOrder, error)
+	ListOrders(context.Context, *ListOrdersReq) (*ListOrdersRes, error)
+	mustEmbedUnimplementedPaymentGrpcServiceServer()
+}
+
+// UnimplementedPaymentGrpcServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPaymentGrpcServiceServer struct {
+}
+
+func (UnimplementedPaymentGrpcServiceServer) BuyItems(context.Context, *BuyItemsReq) (*PaymentOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BuyItems not implemented")
+}
+func (UnimplementedPaymentGrpcServiceServer) SellItems(context.Context, *SellItemsReq) (*PaymentOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SellItems not implemented")
+}
+func (UnimplementedPaymentGrpcServiceServer) GetOrder(context.Context, *GetOrderReq) (*PaymentOrder, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+}
+func (UnimplementedPaymentGrpcServiceServer) ListOrders(context.Context, *ListOrdersReq) (*ListOrdersRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrders not implemented")
+}
+func (UnimplementedPaymentGrpcServiceServer) mustEmbedUnimplementedPaymentGrpcServiceServer() {}
+
+// UnsafePaymentGrpcServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PaymentGrpcServiceServer will
+// result in compilation errors.
+type UnsafePaymentGrpcServiceServer interface {
+	mustEmbedUnimplementedPaymentGrpcServiceServer()
+}
+
+func RegisterPaymentGrpcServiceServer(s grpc.ServiceRegistrar, srv PaymentGrpcServiceServer) {
+	s.RegisterService(&PaymentGrpcService_ServiceDesc, srv)
+}
+
+func _PaymentGrpcService_BuyItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BuyItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentGrpcServiceServer).BuyItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/PaymentGrpcService/BuyItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentGrpcServiceServer).BuyItems(ctx, req.(*BuyItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentGrpcService_SellItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SellItemsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentGrpcServiceServer).SellItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/PaymentGrpcService/SellItems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentGrpcServiceServer).SellItems(ctx, req.(*SellItemsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentGrpcService_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentGrpcServiceServer).GetOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/PaymentGrpcService/GetOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentGrpcServiceServer).GetOrder(ctx, req.(*GetOrderReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentGrpcService_ListOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrdersReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentGrpcServiceServer).ListOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/PaymentGrpcService/ListOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentGrpcServiceServer).ListOrders(ctx, req.(*ListOrdersReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PaymentGrpcService_ServiceDesc is the grpc.ServiceDesc for PaymentGrpcService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PaymentGrpcService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "PaymentGrpcService",
+	HandlerType: (*PaymentGrpcServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "BuyItems",
+			Handler:    _PaymentGrpcService_BuyItems_Handler,
+		},
+		{
+			MethodName: "SellItems",
+			Handler:    _PaymentGrpcService_SellItems_Handler,
+		},
+		{
+			MethodName: "GetOrder",
+			Handler:    _PaymentGrpcService_GetOrder_Handler,
+		},
+		{
+			MethodName: "ListOrders",
+			Handler:    _PaymentGrpcService_ListOrders_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "modules/payment/paymentPb/paymentPb.proto",
+}
@@ -11,11 +11,16 @@ import (
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/inventory"
 	itemPb "github.com/Applessr/hello-sekai-shop-tutorial/modules/item/itemPb"
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/models"
+	"github.com/Applessr/hello-sekai-shop-tutorial/modules/payment"
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/player"
+	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/gacha"
 	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/grpccon"
+	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/itemcache"
 	jwtAuth "github.com/Applessr/hello-sekai-shop-tutorial/pkg/jwtauth"
 	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/queue"
+	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/utils"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
@@ -25,6 +30,9 @@ type (
 		GetOffset(pctx context.Context) (int64, error)
 		UpserOffset(pctx context.Context, offset int64) error
 		FindItemsInIds(pctx context.Context, grpcUrl string, req *itemPb.FindItemInIdsReq) (*itemPb.FindItemInIdsRes, error)
+		GetItemPrices(pctx context.Context, grpcUrl string, req *itemPb.GetItemPricesReq) (*itemPb.GetItemPricesRes, error)
+		FindItemsInIdsFromSource(pctx context.Context, grpcUrl string, req *itemPb.FindItemInIdsReq) (*itemPb.FindItemInIdsRes, error)
+		CheckStorefront(pctx context.Context, grpcUrl string, req *itemPb.CheckStorefrontReq) (*itemPb.CheckStorefrontRes, error)
 		DockedPlayerMoney(pctx context.Context, cfg *config.Config, req *player.CreatePlayerTransactionReq) error
 		RollbackTransaction(pctx context.Context, cfg *config.Config, req *player.RollbackPlayerTransactionReq) error
 		AddPlayerItem(pctx context.Context, cfg *config.Config, req *inventory.UpdateInventoryReq) error
@@ -32,15 +40,28 @@ type (
 		RemovePlayerItem(pctx context.Context, cfg *config.Config, req *inventory.UpdateInventoryReq) error
 		RollbackRemovePlayerItem(pctx context.Context, cfg *config.Config, req *inventory.RollbackPlayerInventoryReq) error
 		AddPlayerMoney(pctx context.Context, cfg *config.Config, req *player.CreatePlayerTransactionReq) error
+		FindOneLootBox(pctx context.Context, grpcUrl string, req *itemPb.FindOneLootBoxReq) (*itemPb.LootBox, error)
+		FindOrInsertLootBoxSeed(pctx context.Context, playerId string) (*payment.LootBoxSeed, error)
+		ReserveLootBoxNonces(pctx context.Context, seedId primitive.ObjectID, count int64) (int64, error)
+		RevealLootBoxSeed(pctx context.Context, playerId string) (*payment.LootBoxSeed, error)
+		IncreaseLootBoxPity(pctx context.Context, playerId, lootBoxId string, delta int) (int, error)
+		InsertManyLootBoxDraws(pctx context.Context, req []*payment.LootBoxDraw) error
+		InsertOneOrder(pctx context.Context, req *payment.Order) (primitive.ObjectID, error)
+		FindOneOrder(pctx context.Context, orderId string) (*payment.Order, error)
+		FindOneOrderByRequestId(pctx context.Context, playerId, requestId string) (*payment.Order, error)
+		UpdateOneOrder(pctx context.Context, req *payment.Order) error
+		FailStaleOrder(pctx context.Context, orderId primitive.ObjectID, before time.Time, reason string) (*payment.Order, error)
+		FindOrders(pctx context.Context, filter primitive.D, opts []*options.FindOptions) ([]*payment.Order, error)
 	}
 
 	paymentRepository struct {
-		db *mongo.Client
+		db        *mongo.Client
+		itemCache itemcache.ItemCacheService
 	}
 )
 
-func NewPaymentRepository(db *mongo.Client) PaymentRepositoryService {
-	return &paymentRepository{db}
+func NewPaymentRepository(db *mongo.Client, itemCache itemcache.ItemCacheService) PaymentRepositoryService {
+	return &paymentRepository{db, itemCache}
 }
 
 func (r *paymentRepository) paymentDbConnect(pctx context.Context) *mongo.Database {
@@ -81,6 +102,17 @@ func (r *paymentRepository) UpserOffset(pctx context.Context, offset int64) erro
 }
 
 func (r *paymentRepository) FindItemsInIds(pctx context.Context, grpcUrl string, req *itemPb.FindItemInIdsReq) (*itemPb.FindItemInIdsRes, error) {
+	if r.itemCache == nil {
+		return r.FindItemsInIdsFromSource(pctx, grpcUrl, req)
+	}
+
+	return r.itemCache.FindItemInIds(pctx, req, func(pctx context.Context, req *itemPb.FindItemInIdsReq) (*itemPb.FindItemInIdsRes, error) {
+		return r.FindItemsInIdsFromSource(pctx, grpcUrl, req)
+	})
+}
+
+// FindItemsInIdsFromSource always asks the item service and refreshes the cache with the answer
+func (r *paymentRepository) FindItemsInIdsFromSource(pctx context.Context, grpcUrl string, req *itemPb.FindItemInIdsReq) (*itemPb.FindItemInIdsRes, error) {
 	ctx, cancel := context.WithTimeout(pctx, 30*time.Second)
 	defer cancel()
 
@@ -107,6 +139,10 @@ func (r *paymentRepository) FindItemsInIds(pctx context.Context, grpcUrl string,
 		return nil, errors.New("error: items not found")
 	}
 
+	if r.itemCache != nil {
+		r.itemCache.Put(result.Items)
+	}
+
 	return result, nil
 }
 
@@ -263,3 +299,317 @@ func (r *paymentRepository) RollbackRemovePlayerItem(pctx context.Context, cfg *
 
 	return nil
 }
+
+func (r *paymentRepository) GetItemPrices(pctx context.Context, grpcUrl string, req *itemPb.GetItemPricesReq) (*itemPb.GetItemPricesRes, error) {
+	ctx, cancel := context.WithTimeout(pctx, 30*time.Second)
+	defer cancel()
+
+	jwtAuth.SetApiKeyInContext(&ctx)
+	conn, err := grpccon.NewGrpcClient(grpcUrl)
+	if err != nil {
+		log.Printf("Error: gRPC connection failed: %s", err.Error())
+		return nil, errors.New("error: gRPC connection failed")
+	}
+
+	result, err := conn.Item().GetItemPrices(ctx, req)
+	if err != nil {
+		log.Printf("Error: GetItemPrices failed: %s", err.Error())
+		return nil, errors.New("error: get item prices failed")
+	}
+
+	return result, nil
+}
+
+func (r *paymentRepository) CheckStorefront(pctx context.Context, grpcUrl string, req *itemPb.CheckStorefrontReq) (*itemPb.CheckStorefrontRes, error) {
+	ctx, cancel := context.WithTimeout(pctx, 30*time.Second)
+	defer cancel()
+
+	jwtAuth.SetApiKeyInContext(&ctx)
+	conn, err := grpccon.NewGrpcClient(grpcUrl)
+	if err != nil {
+		log.Printf("Error: gRPC connection failed: %s", err.Error())
+		return nil, errors.New("error: gRPC connection failed")
+	}
+
+	result, err := conn.Item().CheckStorefront(ctx, req)
+	if err != nil {
+		log.Printf("Error: CheckStorefront failed: %s", err.Error())
+		return nil, errors.New("error: check storefront failed")
+	}
+
+	return result, nil
+}
+
+func (r *paymentRepository) FindOneLootBox(pctx context.Context, grpcUrl string, req *itemPb.FindOneLootBoxReq) (*itemPb.LootBox, error) {
+	ctx, cancel := context.WithTimeout(pctx, 30*time.Second)
+	defer cancel()
+
+	jwtAuth.SetApiKeyInContext(&ctx)
+	conn, err := grpccon.NewGrpcClient(grpcUrl)
+	if err != nil {
+		log.Printf("Error: gRPC connection failed: %s", err.Error())
+		return nil, errors.New("error: gRPC connection failed")
+	}
+
+	result, err := conn.Item().FindOneLootBox(ctx, req)
+	if err != nil {
+		log.Printf("Error: FindOneLootBox failed: %s", err.Error())
+		return nil, errors.New("error: loot box not found")
+	}
+
+	if len(result.Drops) == 0 {
+		log.Printf("Error: FindOneLootBox failed: loot box %s has no drops", req.Id)
+		return nil, errors.New("error: loot box not found")
+	}
+
+	return result, nil
+}
+
+func (r *paymentRepository) FindOrInsertLootBoxSeed(pctx context.Context, playerId string) (*payment.LootBoxSeed, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.paymentDbConnect(ctx)
+	col := db.Collection("loot_box_seeds")
+
+	serverSeed := gacha.NewServerSeed()
+
+	findOrInsert := func() (*payment.LootBoxSeed, error) {
+		result := new(payment.LootBoxSeed)
+		err := col.FindOneAndUpdate(
+			ctx,
+			bson.M{"player_id": playerId, "is_active": true},
+			bson.M{"$setOnInsert": bson.M{
+				"server_seed":      serverSeed,
+				"server_seed_hash": gacha.HashSeed(serverSeed),
+				"nonce":            0,
+				"created_at":       utils.LocalTime(),
+			}},
+			options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+		).Decode(result)
+		return result, err
+	}
+
+	// Two first opens at once race on the insert, the unique index lets only one seed in and
+	// the loser reads the winner's seed
+	result, err := findOrInsert()
+	if mongo.IsDuplicateKeyError(err) {
+		result, err = findOrInsert()
+	}
+	if err != nil {
+		log.Printf("Error: FindOrInsertLootBoxSeed failed: %s", err.Error())
+		return nil, errors.New("error: find loot box seed failed")
+	}
+
+	return result, nil
+}
+
+func (r *paymentRepository) ReserveLootBoxNonces(pctx context.Context, seedId primitive.ObjectID, count int64) (int64, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.paymentDbConnect(ctx)
+	col := db.Collection("loot_box_seeds")
+
+	result := new(payment.LootBoxSeed)
+	if err := col.FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": seedId, "is_active": true},
+		bson.M{"$inc": bson.M{"nonce": count}},
+		options.FindOneAndUpdate().SetReturnDocument(options.Before),
+	).Decode(result); err != nil {
+		log.Printf("Error: ReserveLootBoxNonces failed: %s", err.Error())
+		return -1, errors.New("error: reserve loot box nonces failed")
+	}
+
+	return result.Nonce, nil
+}
+
+func (r *paymentRepository) RevealLootBoxSeed(pctx context.Context, playerId string) (*payment.LootBoxSeed, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.paymentDbConnect(ctx)
+	col := db.Collection("loot_box_seeds")
+
+	result := new(payment.LootBoxSeed)
+	if err := col.FindOneAndUpdate(
+		ctx,
+		bson.M{"player_id": playerId, "is_active": true},
+		bson.M{"$set": bson.M{"is_active": false, "revealed_at": utils.LocalTime()}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(result); err != nil {
+		log.Printf("Error: RevealLootBoxSeed failed: %s", err.Error())
+		return nil, errors.New("error: reveal loot box seed failed")
+	}
+
+	return result, nil
+}
+
+// IncreaseLootBoxPity moves the counter by delta in one write, it never goes below zero, and
+// returns the count from before so concurrent opens each get their own stretch of draws
+func (r *paymentRepository) IncreaseLootBoxPity(pctx context.Context, playerId, lootBoxId string, delta int) (int, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.paymentDbConnect(ctx)
+	col := db.Collection("loot_box_pity")
+
+	result := new(payment.LootBoxPity)
+	err := col.FindOneAndUpdate(
+		ctx,
+		bson.M{"player_id": playerId, "loot_box_id": lootBoxId},
+		mongo.Pipeline{
+			{{"$set", bson.D{{"count", bson.D{{"$max", bson.A{0, bson.D{{"$add", bson.A{bson.D{{"$ifNull", bson.A{"$count", 0}}}, delta}}}}}}}}}},
+		},
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.Before),
+	).Decode(result)
+	if err == mongo.ErrNoDocuments {
+		return 0, nil
+	}
+	if err != nil {
+		log.Printf("Error: IncreaseLootBoxPity failed: %s", err.Error())
+		return -1, errors.New("error: update loot box pity failed")
+	}
+
+	return result.Count, nil
+}
+
+func (r *paymentRepository) InsertManyLootBoxDraws(pctx context.Context, req []*payment.LootBoxDraw) error {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.paymentDbConnect(ctx)
+	col := db.Collection("loot_box_draws")
+
+	docs := make([]any, 0)
+	for _, v := range req {
+		docs = append(docs, v)
+	}
+
+	if _, err := col.InsertMany(ctx, docs); err != nil {
+		log.Printf("Error: InsertManyLootBoxDraws failed: %s", err.Error())
+		return errors.New("error: insert loot box draws failed")
+	}
+
+	return nil
+}
+
+func (r *paymentRepository) InsertOneOrder(pctx context.Context, req *payment.Order) (primitive.ObjectID, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.paymentDbConnect(ctx)
+	col := db.Collection("payment_orders")
+
+	result, err := col.InsertOne(ctx, req)
+	if err != nil {
+		log.Printf("Error: InsertOneOrder failed: %s", err.Error())
+		return primitive.NilObjectID, errors.New("error: insert one order failed")
+	}
+
+	return result.InsertedID.(primitive.ObjectID), nil
+}
+
+func (r *paymentRepository) FindOneOrder(pctx context.Context, orderId string) (*payment.Order, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.paymentDbConnect(ctx)
+	col := db.Collection("payment_orders")
+
+	result := new(payment.Order)
+
+	if err := col.FindOne(ctx, bson.M{"_id": utils.ConvertToObjectId(orderId)}).Decode(result); err != nil {
+		log.Printf("Error: FindOneOrder failed: %s", err.Error())
+		return nil, errors.New("error: order not found")
+	}
+
+	return result, nil
+}
+
+func (r *paymentRepository) FindOneOrderByRequestId(pctx context.Context, playerId, requestId string) (*payment.Order, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.paymentDbConnect(ctx)
+	col := db.Collection("payment_orders")
+
+	result := new(payment.Order)
+
+	if err := col.FindOne(ctx, bson.M{"player_id": playerId, "request_id": requestId}).Decode(result); err != nil {
+		log.Printf("Error: FindOneOrderByRequestId failed: %s", err.Error())
+		return nil, errors.New("error: order not found")
+	}
+
+	return result, nil
+}
+
+func (r *paymentRepository) UpdateOneOrder(pctx context.Context, req *payment.Order) error {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.paymentDbConnect(ctx)
+	col := db.Collection("payment_orders")
+
+	if _, err := col.ReplaceOne(ctx, bson.M{"_id": req.Id}, req); err != nil {
+		log.Printf("Error: UpdateOneOrder failed: %s", err.Error())
+		return errors.New("error: update one order failed")
+	}
+
+	return nil
+}
+
+// FailStaleOrder fails the order if it is still pending since before the given time, it returns
+// nil without error when the order has moved on in the meantime
+func (r *paymentRepository) FailStaleOrder(pctx context.Context, orderId primitive.ObjectID, before time.Time, reason string) (*payment.Order, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.paymentDbConnect(ctx)
+	col := db.Collection("payment_orders")
+
+	result := new(payment.Order)
+	err := col.FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": orderId, "status": "pending", "created_at": bson.M{"$lt": before}},
+		bson.M{"$set": bson.M{"status": "failed", "error": reason}},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(result)
+	if err == mongo.ErrNoDocuments {
+		return nil, nil
+	}
+	if err != nil {
+		log.Printf("Error: FailStaleOrder failed: %s", err.Error())
+		return nil, errors.New("error: fail stale order failed")
+	}
+
+	return result, nil
+}
+
+func (r *paymentRepository) FindOrders(pctx context.Context, filter primitive.D, opts []*options.FindOptions) ([]*payment.Order, error) {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.paymentDbConnect(ctx)
+	col := db.Collection("payment_orders")
+
+	cursors, err := col.Find(ctx, filter, opts...)
+	if err != nil {
+		log.Printf("Error: FindOrders failed: %s", err.Error())
+		return nil, errors.New("error: orders not found")
+	}
+
+	results := make([]*payment.Order, 0)
+	for cursors.Next(ctx) {
+		result := new(payment.Order)
+		if err := cursors.Decode(result); err != nil {
+			log.Printf("Error: FindOrders failed: %s", err.Error())
+			return nil, errors.New("error: orders not found")
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,301 @@
+package paymentUsecase
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/Applessr/hello-sekai-shop-tutorial/config"
+	"github.com/Applessr/hello-sekai-shop-tutorial/modules/payment"
+	paymentPb "github.com/Applessr/hello-sekai-shop-tutorial/modules/payment/paymentPb"
+	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// staleOrderAfter is how long an order may stay pending before it is taken as interrupted,
+// a buy or sell finishes well within it
+const staleOrderAfter = 5 * time.Minute
+
+func newOrder(orderType, source, reason, playerId, requestId string) *payment.Order {
+	return &payment.Order{
+		PlayerId:  playerId,
+		RequestId: requestId,
+		Type:      orderType,
+		Status:    "pending",
+		Source:    source,
+		Reason:    reason,
+		Items:     make([]*payment.OrderItem, 0),
+		CreatedAt: utils.LocalTime(),
+	}
+}
+
+// reserveOrder stores the order as pending under its request id before anything moves,
+// a replay gets the order that was stored first instead of buying or selling again
+func (u *paymentUsecase) reserveOrder(pctx context.Context, order *payment.Order) (*payment.Order, error) {
+	if existing, err := u.paymentRepository.FindOneOrderByRequestId(pctx, order.PlayerId, order.RequestId); err == nil {
+		return existing, nil
+	}
+
+	orderId, err := u.paymentRepository.InsertOneOrder(pctx, order)
+	if err != nil {
+		// Lost the race against a concurrent replay
+		if existing, err := u.paymentRepository.FindOneOrderByRequestId(pctx, order.PlayerId, order.RequestId); err == nil {
+			return existing, nil
+		}
+		return nil, err
+	}
+	order.Id = orderId
+
+	return nil, nil
+}
+
+// replayOrder answers a retry with the stored order. An order left pending by a process that died
+// mid-way is failed rather than run again, since the transfers it already made can't be told apart
+func (u *paymentUsecase) replayOrder(pctx context.Context, order *payment.Order) (*paymentPb.PaymentOrder, error) {
+	if order.Status == "pending" && utils.LocalTime().Sub(order.CreatedAt) > staleOrderAfter {
+		failed, err := u.paymentRepository.FailStaleOrder(pctx, order.Id, utils.LocalTime().Add(-staleOrderAfter), "error: order was interrupted")
+		if err != nil {
+			return nil, err
+		}
+		if failed == nil {
+			// Another retry settled it first
+			if failed, err = u.paymentRepository.FindOneOrderByRequestId(pctx, order.PlayerId, order.RequestId); err != nil {
+				return nil, err
+			}
+		} else {
+			log.Printf("Error: replayOrder: %s order %s for player %s was interrupted", failed.Type, failed.Id.Hex(), failed.PlayerId)
+		}
+		order = failed
+	}
+
+	switch order.Status {
+	case "pending":
+		return nil, errors.New("error: order is still processing")
+	case "failed":
+		return nil, errors.New(order.Error)
+	}
+	return toPbOrder(order), nil
+}
+
+// recordOrder keeps the outcome of a buy or sell, a failure to store it is only logged
+// because the money and items have already moved by then
+func (u *paymentUsecase) recordOrder(pctx context.Context, order *payment.Order, req *payment.ItemServiceReq, res []*payment.PaymentTransferRes, err error) *payment.Order {
+	order.Status = "completed"
+	if err != nil {
+		order.Status = "failed"
+		order.Error = err.Error()
+	}
+
+	for _, v := range req.Items {
+		price := v.Price
+		if order.Type == "sell" {
+			price = v.Price * sellRate
+		}
+		order.Items = append(order.Items, &payment.OrderItem{ItemId: v.ItemId, Price: price})
+	}
+
+	// Only the transfers that went through count, every entry of a bundle shares its transaction
+	counted := make(map[string]bool)
+	for _, v := range res {
+		if v.Error != "" {
+			continue
+		}
+		if v.TransactionId != "" {
+			if counted[v.TransactionId] {
+				continue
+			}
+			counted[v.TransactionId] = true
+		}
+		if order.Type == "sell" {
+			order.Total += v.Amount * sellRate
+		} else {
+			order.Total += v.Amount
+		}
+	}
+
+	if order.Type == "buy" {
+		for _, v := range res {
+			order.Grants = append(order.Grants, &payment.OrderGrant{ItemId: v.ItemId, InventoryId: v.InventoryId})
+		}
+	}
+
+	if !order.Id.IsZero() {
+		if err := u.paymentRepository.UpdateOneOrder(pctx, order); err != nil {
+			log.Printf("Error: recordOrder failed: %s order %s for player %s: %s", order.Type, order.Id.Hex(), order.PlayerId, err.Error())
+		}
+		return order
+	}
+
+	orderId, insertErr := u.paymentRepository.InsertOneOrder(pctx, order)
+	if insertErr != nil {
+		log.Printf("Error: recordOrder failed: %s order for player %s: %s", order.Type, order.PlayerId, insertErr.Error())
+		return order
+	}
+	order.Id = orderId
+
+	return order
+}
+
+func toItemServiceReq(itemIds []string) (*payment.ItemServiceReq, error) {
+	if len(itemIds) == 0 {
+		return nil, errors.New("error: item ids are required")
+	}
+
+	req := &payment.ItemServiceReq{
+		Items: make([]*payment.ItemServiceReqDatum, 0),
+	}
+	for _, itemId := range itemIds {
+		if itemId == "" || len(itemId) > 64 {
+			return nil, errors.New("error: item id is invalid")
+		}
+		req.Items = append(req.Items, &payment.ItemServiceReqDatum{ItemId: itemId})
+	}
+
+	return req, nil
+}
+
+func (u *paymentUsecase) BuyItems(pctx context.Context, cfg *config.Config, req *paymentPb.BuyItemsReq) (*paymentPb.PaymentOrder, error) {
+	if req.PlayerId == "" {
+		return nil, errors.New("error: player id is required")
+	}
+
+	itemReq, err := toItemServiceReq(req.ItemIds)
+	if err != nil {
+		return nil, err
+	}
+	itemReq.PriceVersion = req.PriceVersion
+
+	order := newOrder("buy", "grpc", req.Reason, req.PlayerId, req.RequestId)
+	if req.RequestId != "" {
+		existing, err := u.reserveOrder(pctx, order)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			return u.replayOrder(pctx, existing)
+		}
+	}
+
+	res, err := u.buyItem(pctx, cfg, req.PlayerId, itemReq)
+	order = u.recordOrder(pctx, order, itemReq, res, err)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPbOrder(order), nil
+}
+
+func (u *paymentUsecase) SellItems(pctx context.Context, cfg *config.Config, req *paymentPb.SellItemsReq) (*paymentPb.PaymentOrder, error) {
+	if req.PlayerId == "" {
+		return nil, errors.New("error: player id is required")
+	}
+
+	itemReq, err := toItemServiceReq(req.ItemIds)
+	if err != nil {
+		return nil, err
+	}
+
+	order := newOrder("sell", "grpc", req.Reason, req.PlayerId, req.RequestId)
+	if req.RequestId != "" {
+		existing, err := u.reserveOrder(pctx, order)
+		if err != nil {
+			return nil, err
+		}
+		if existing != nil {
+			return u.replayOrder(pctx, existing)
+		}
+	}
+
+	res, err := u.sellItem(pctx, cfg, req.PlayerId, itemReq)
+	order = u.recordOrder(pctx, order, itemReq, res, err)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPbOrder(order), nil
+}
+
+func (u *paymentUsecase) GetOrder(pctx context.Context, req *paymentPb.GetOrderReq) (*paymentPb.PaymentOrder, error) {
+	order, err := u.paymentRepository.FindOneOrder(pctx, req.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPbOrder(order), nil
+}
+
+func (u *paymentUsecase) ListOrders(pctx context.Context, req *paymentPb.ListOrdersReq) (*paymentPb.ListOrdersRes, error) {
+	if req.PlayerId == "" {
+		return nil, errors.New("error: player id is required")
+	}
+	if req.Type != "" && req.Type != "buy" && req.Type != "sell" {
+		return nil, errors.New("error: type must be buy or sell")
+	}
+
+	limit := int64(req.Limit)
+	if limit <= 0 {
+		limit = 20
+	}
+	if limit > 100 {
+		limit = 100
+	}
+
+	filter := bson.D{}
+	if req.Start != "" {
+		filter = append(filter, bson.E{"_id", bson.D{{"$lt", utils.ConvertToObjectId(req.Start)}}})
+	}
+	filter = append(filter, bson.E{"player_id", req.PlayerId})
+	if req.Type != "" {
+		filter = append(filter, bson.E{"type", req.Type})
+	}
+
+	opts := make([]*options.FindOptions, 0)
+	opts = append(opts, options.Find().SetSort(bson.D{{"_id", -1}}))
+	opts = append(opts, options.Find().SetLimit(limit))
+
+	orders, err := u.paymentRepository.FindOrders(pctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &paymentPb.ListOrdersRes{
+		Orders: make([]*paymentPb.PaymentOrder, 0),
+	}
+	for _, v := range orders {
+		res.Orders = append(res.Orders, toPbOrder(v))
+	}
+	if int64(len(orders)) == limit {
+		res.NextStart = orders[len(orders)-1].Id.Hex()
+	}
+
+	return res, nil
+}
+
+func toPbOrder(v *payment.Order) *paymentPb.PaymentOrder {
+	loc, _ := time.LoadLocation("Asia/Bangkok")
+
+	order := &paymentPb.PaymentOrder{
+		Id:        v.Id.Hex(),
+		PlayerId:  v.PlayerId,
+		RequestId: v.RequestId,
+		Type:      v.Type,
+		Status:    v.Status,
+		Source:    v.Source,
+		Reason:    v.Reason,
+		Items:     make([]*paymentPb.PaymentOrderItem, 0),
+		Grants:    make([]*paymentPb.PaymentOrderGrant, 0),
+		Total:     v.Total,
+		Error:     v.Error,
+		CreatedAt: v.CreatedAt.In(loc).String(),
+	}
+	for _, item := range v.Items {
+		order.Items = append(order.Items, &paymentPb.PaymentOrderItem{ItemId: item.ItemId, Price: item.Price})
+	}
+	for _, grant := range v.Grants {
+		order.Grants = append(order.Grants, &paymentPb.PaymentOrderGrant{ItemId: grant.ItemId, InventoryId: grant.InventoryId})
+	}
+
+	return order
+}
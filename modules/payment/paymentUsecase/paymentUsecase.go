@@ -10,19 +10,34 @@ import (
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/item"
 	itemPb "github.com/Applessr/hello-sekai-shop-tutorial/modules/item/itemPb"
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/payment"
+	paymentPb "github.com/Applessr/hello-sekai-shop-tutorial/modules/payment/paymentPb"
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/payment/paymentRepository"
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/player"
+	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/gacha"
 	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/queue"
+	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/utils"
 	"github.com/IBM/sarama"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
+// sellRate is the share of the item price paid back when a player sells it
+const sellRate = 0.5
+
 type (
 	PaymentUsecaseService interface {
 		GetOffset(pctx context.Context) (int64, error)
 		UpserOffset(pctx context.Context, offset int64) error
 		FindItemsInIds(pctx context.Context, grpcUrl string, req []*payment.ItemServiceReqDatum) error
+		QuoteItems(pctx context.Context, cfg *config.Config, req *payment.ItemServiceReq) (*payment.QuoteRes, error)
 		BuyItem(pctx context.Context, cfg *config.Config, playerId string, req *payment.ItemServiceReq) ([]*payment.PaymentTransferRes, error)
 		SellItem(pctx context.Context, cfg *config.Config, playerId string, req *payment.ItemServiceReq) ([]*payment.PaymentTransferRes, error)
+		GetLootBoxSeed(pctx context.Context, playerId string) (*payment.LootBoxSeedRes, error)
+		RotateLootBoxSeed(pctx context.Context, playerId string) (*payment.LootBoxSeedRes, error)
+		OpenLootBox(pctx context.Context, cfg *config.Config, playerId string, req *payment.OpenLootBoxReq) ([]*payment.LootBoxDrawRes, error)
+		BuyItems(pctx context.Context, cfg *config.Config, req *paymentPb.BuyItemsReq) (*paymentPb.PaymentOrder, error)
+		SellItems(pctx context.Context, cfg *config.Config, req *paymentPb.SellItemsReq) (*paymentPb.PaymentOrder, error)
+		GetOrder(pctx context.Context, req *paymentPb.GetOrderReq) (*paymentPb.PaymentOrder, error)
+		ListOrders(pctx context.Context, req *paymentPb.ListOrdersReq) (*paymentPb.ListOrdersRes, error)
 	}
 
 	paymentUsecase struct {
@@ -49,7 +64,8 @@ func (u *paymentUsecase) FindItemsInIds(pctx context.Context, grpcUrl string, re
 		}
 	}
 
-	itemData, err := u.paymentRepository.FindItemsInIds(pctx, grpcUrl, &itemPb.FindItemInIdsReq{
+	// Buy and sell both price from the item service, the cached copy may be stale
+	itemData, err := u.paymentRepository.FindItemsInIdsFromSource(pctx, grpcUrl, &itemPb.FindItemInIdsReq{
 		Ids: func() []string {
 			itemIds := make([]string, 0)
 			for k := range setIds {
@@ -66,20 +82,28 @@ func (u *paymentUsecase) FindItemsInIds(pctx context.Context, grpcUrl string, re
 	itemMaps := make(map[string]*item.ItemShowCase)
 	for _, v := range itemData.Items {
 		itemMaps[v.Id] = &item.ItemShowCase{
-			ItemId:   v.Id,
-			Title:    v.Title,
-			Price:    v.Price,
-			ImageUrl: v.ImageUrl,
-			Damage:   int(v.Damage),
+			ItemId:      v.Id,
+			Title:       v.Title,
+			Price:       v.Price,
+			ImageUrl:    v.ImageUrl,
+			Damage:      int(v.Damage),
+			BundleItems: v.BundleItems,
 		}
 	}
 
 	for i := range req {
 		if _, ok := itemMaps[req[i].ItemId]; !ok {
-			log.Printf("Error: FindItemsInIds failed: %s", err.Error())
+			log.Printf("Error: FindItemsInIds failed: %s not found", req[i].ItemId)
 			return errors.New("error: items not found")
 		}
+		for _, bundleItemId := range itemMaps[req[i].ItemId].BundleItems {
+			if _, ok := itemMaps[bundleItemId]; !ok {
+				log.Printf("Error: FindItemsInIds failed: bundle item %s not found", bundleItemId)
+				return errors.New("error: items not found")
+			}
+		}
 		req[i].Price = itemMaps[req[i].ItemId].Price
+		req[i].BundleItems = itemMaps[req[i].ItemId].BundleItems
 	}
 
 	return nil
@@ -141,11 +165,96 @@ func (u *paymentUsecase) BuyOrSellConsumer(pctx context.Context, key string, cfg
 	}
 }
 
+func (u *paymentUsecase) QuoteItems(pctx context.Context, cfg *config.Config, req *payment.ItemServiceReq) (*payment.QuoteRes, error) {
+	setIds := make(map[string]bool)
+	itemIds := make([]string, 0)
+	for _, v := range req.Items {
+		if !setIds[v.ItemId] {
+			setIds[v.ItemId] = true
+			itemIds = append(itemIds, v.ItemId)
+		}
+	}
+
+	prices, err := u.paymentRepository.GetItemPrices(pctx, cfg.Grpc.ItemUrl, &itemPb.GetItemPricesReq{Ids: itemIds})
+	if err != nil {
+		return nil, err
+	}
+
+	priceMaps := make(map[string]*itemPb.ItemPrice)
+	for _, v := range prices.Prices {
+		priceMaps[v.Id] = v
+	}
+
+	res := &payment.QuoteRes{
+		Items:        make([]*payment.QuoteItem, 0),
+		PriceVersion: prices.PriceVersion,
+	}
+	for _, v := range req.Items {
+		price, ok := priceMaps[v.ItemId]
+		if !ok {
+			log.Printf("Error: QuoteItems failed: %s not found", v.ItemId)
+			return nil, errors.New("error: items not found")
+		}
+		res.Items = append(res.Items, &payment.QuoteItem{
+			ItemId:        v.ItemId,
+			Price:         price.Price,
+			OriginalPrice: price.OriginalPrice,
+		})
+		res.Total += price.Price
+	}
+
+	return res, nil
+}
+
 func (u *paymentUsecase) BuyItem(pctx context.Context, cfg *config.Config, playerId string, req *payment.ItemServiceReq) ([]*payment.PaymentTransferRes, error) {
+	res, err := u.buyItem(pctx, cfg, playerId, req)
+	u.recordOrder(pctx, newOrder("buy", "http", "", playerId, ""), req, res, err)
+	return res, err
+}
+
+func (u *paymentUsecase) buyItem(pctx context.Context, cfg *config.Config, playerId string, req *payment.ItemServiceReq) ([]*payment.PaymentTransferRes, error) {
+	// Ties every inventory step of this purchase together in the inventory history
+	sagaId := primitive.NewObjectID().Hex()
+
+	// Prices are charged, so they come from the item service rather than the cache
 	if err := u.FindItemsInIds(pctx, cfg.Grpc.ItemUrl, req.Items); err != nil {
 		return nil, err
 	}
 
+	// Storefront items are only on sale while they sit in the player's current rotation
+	itemIds := make([]string, 0)
+	for _, v := range req.Items {
+		itemIds = append(itemIds, v.ItemId)
+	}
+	storefront, err := u.paymentRepository.CheckStorefront(pctx, cfg.Grpc.ItemUrl, &itemPb.CheckStorefrontReq{
+		PlayerId: playerId,
+		ItemIds:  itemIds,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(storefront.Blocked) > 0 {
+		log.Printf("Error: BuyItem failed: %v not in the storefront rotation of %s", storefront.Blocked, playerId)
+		return nil, errors.New("error: item is not in your current storefront rotation")
+	}
+
+	// A quoted price version means the player agreed to those prices, refuse if they moved since
+	if req.PriceVersion != "" {
+		quote, err := u.QuoteItems(pctx, cfg, req)
+		if err != nil {
+			return nil, err
+		}
+		if quote.PriceVersion != req.PriceVersion {
+			log.Printf("Error: BuyItem failed: price version %s is now %s", req.PriceVersion, quote.PriceVersion)
+			return nil, errors.New("error: price changed, please review the new price")
+		}
+		for i := range req.Items {
+			if req.Items[i].Price != quote.Items[i].Price {
+				return nil, errors.New("error: price changed, please review the new price")
+			}
+		}
+	}
+
 	stage1 := make([]*payment.PaymentTransferRes, 0)
 	for _, item := range req.Items {
 		u.paymentRepository.DockedPlayerMoney(pctx, cfg, &player.CreatePlayerTransactionReq{
@@ -183,27 +292,41 @@ func (u *paymentUsecase) BuyItem(pctx context.Context, cfg *config.Config, playe
 
 	}
 
+	// A bundle is granted as every item it contains
+	grantItemIds := func(itemId string) []string {
+		for _, v := range req.Items {
+			if v.ItemId == itemId && len(v.BundleItems) > 0 {
+				return v.BundleItems
+			}
+		}
+		return []string{itemId}
+	}
+
 	stage2 := make([]*payment.PaymentTransferRes, 0)
 	for _, s1 := range stage1 {
-		u.paymentRepository.AddPlayerItem(pctx, cfg, &inventory.UpdateInventoryReq{
-			PlayerId: playerId,
-			ItemId:   s1.ItemId,
-		})
+		for _, itemId := range grantItemIds(s1.ItemId) {
+			u.paymentRepository.AddPlayerItem(pctx, cfg, &inventory.UpdateInventoryReq{
+				PlayerId: playerId,
+				ItemId:   itemId,
+				SagaId:   sagaId,
+			})
 
-		resCh := make(chan *payment.PaymentTransferRes)
+			resCh := make(chan *payment.PaymentTransferRes)
 
-		go u.BuyOrSellConsumer(pctx, "buy", cfg, resCh)
+			go u.BuyOrSellConsumer(pctx, "buy", cfg, resCh)
 
-		res := <-resCh
-		if res != nil {
+			res := <-resCh
+			if res == nil {
+				res = &payment.PaymentTransferRes{Error: "error: add player item failed"}
+			}
 			log.Println(res)
 			stage2 = append(stage2, &payment.PaymentTransferRes{
 				InventoryId:   res.InventoryId,
 				TransactionId: s1.TransactionId,
 				PlayerId:      playerId,
-				ItemId:        s1.ItemId,
+				ItemId:        itemId,
 				Amount:        s1.Amount,
-				Error:         s1.Error,
+				Error:         res.Error,
 			})
 		}
 	}
@@ -211,17 +334,23 @@ func (u *paymentUsecase) BuyItem(pctx context.Context, cfg *config.Config, playe
 	for _, s2 := range stage2 {
 		if s2.Error != "" {
 			for _, ss2 := range stage2 {
-				u.paymentRepository.RollbackAddPlayerItem(pctx, cfg, &inventory.RollbackPlayerInventoryReq{
-					InventoryId: ss2.InventoryId,
-				})
+				if ss2.InventoryId != "" {
+					u.paymentRepository.RollbackAddPlayerItem(pctx, cfg, &inventory.RollbackPlayerInventoryReq{
+						InventoryId: ss2.InventoryId,
+						SagaId:      sagaId,
+					})
+				}
 			}
 
-			for _, ss2 := range stage2 {
+			for _, ss1 := range stage1 {
 				u.paymentRepository.RollbackTransaction(pctx, cfg, &player.RollbackPlayerTransactionReq{
-					TransactionId: ss2.TransactionId,
+					TransactionId: ss1.TransactionId,
 				})
 			}
 
+			if s2.Error == inventory.ErrInventoryFull.Error() {
+				return nil, inventory.ErrInventoryFull
+			}
 			return nil, errors.New("error: buy item failed")
 		}
 	}
@@ -230,6 +359,14 @@ func (u *paymentUsecase) BuyItem(pctx context.Context, cfg *config.Config, playe
 }
 
 func (u *paymentUsecase) SellItem(pctx context.Context, cfg *config.Config, playerId string, req *payment.ItemServiceReq) ([]*payment.PaymentTransferRes, error) {
+	res, err := u.sellItem(pctx, cfg, playerId, req)
+	u.recordOrder(pctx, newOrder("sell", "http", "", playerId, ""), req, res, err)
+	return res, err
+}
+
+func (u *paymentUsecase) sellItem(pctx context.Context, cfg *config.Config, playerId string, req *payment.ItemServiceReq) ([]*payment.PaymentTransferRes, error) {
+	sagaId := primitive.NewObjectID().Hex()
+
 	if err := u.FindItemsInIds(pctx, cfg.Grpc.ItemUrl, req.Items); err != nil {
 		return nil, err
 	}
@@ -239,6 +376,7 @@ func (u *paymentUsecase) SellItem(pctx context.Context, cfg *config.Config, play
 		u.paymentRepository.RemovePlayerItem(pctx, cfg, &inventory.UpdateInventoryReq{
 			PlayerId: playerId,
 			ItemId:   item.ItemId,
+			SagaId:   sagaId,
 		})
 
 		resCh := make(chan *payment.PaymentTransferRes)
@@ -262,13 +400,17 @@ func (u *paymentUsecase) SellItem(pctx context.Context, cfg *config.Config, play
 	for _, s1 := range stage1 {
 		if s1.Error != "" {
 			for _, ss1 := range stage1 {
-				if ss1.Error != "error: item not found" {
+				if ss1.Error == "" {
 					u.paymentRepository.RollbackRemovePlayerItem(pctx, cfg, &inventory.RollbackPlayerInventoryReq{
 						PlayerId: playerId,
 						ItemId:   ss1.ItemId,
+						SagaId:   sagaId,
 					})
 				}
 			}
+			if s1.Error == "error: item is equipped" {
+				return nil, errors.New(s1.Error)
+			}
 			return nil, errors.New("error: sell item failed")
 		}
 	}
@@ -277,7 +419,7 @@ func (u *paymentUsecase) SellItem(pctx context.Context, cfg *config.Config, play
 	for _, s1 := range stage1 {
 		u.paymentRepository.AddPlayerMoney(pctx, cfg, &player.CreatePlayerTransactionReq{
 			PlayerId: playerId,
-			Amount:   s1.Amount * 0.5,
+			Amount:   s1.Amount * sellRate,
 		})
 
 		resCh := make(chan *payment.PaymentTransferRes)
@@ -310,6 +452,9 @@ func (u *paymentUsecase) SellItem(pctx context.Context, cfg *config.Config, play
 				if ss2.Error != "error: item not found" {
 					u.paymentRepository.RollbackRemovePlayerItem(pctx, cfg, &inventory.RollbackPlayerInventoryReq{
 						InventoryId: ss2.InventoryId,
+						PlayerId:    playerId,
+						ItemId:      ss2.ItemId,
+						SagaId:      sagaId,
 					})
 				}
 			}
@@ -320,3 +465,189 @@ func (u *paymentUsecase) SellItem(pctx context.Context, cfg *config.Config, play
 
 	return stage2, nil
 }
+
+func (u *paymentUsecase) GetLootBoxSeed(pctx context.Context, playerId string) (*payment.LootBoxSeedRes, error) {
+	seed, err := u.paymentRepository.FindOrInsertLootBoxSeed(pctx, playerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &payment.LootBoxSeedRes{
+		ServerSeedHash: seed.ServerSeedHash,
+		Nonce:          seed.Nonce,
+	}, nil
+}
+
+func (u *paymentUsecase) RotateLootBoxSeed(pctx context.Context, playerId string) (*payment.LootBoxSeedRes, error) {
+	if _, err := u.paymentRepository.FindOrInsertLootBoxSeed(pctx, playerId); err != nil {
+		return nil, err
+	}
+
+	previous, err := u.paymentRepository.RevealLootBoxSeed(pctx, playerId)
+	if err != nil {
+		return nil, err
+	}
+
+	seed, err := u.paymentRepository.FindOrInsertLootBoxSeed(pctx, playerId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &payment.LootBoxSeedRes{
+		ServerSeedHash:         seed.ServerSeedHash,
+		Nonce:                  seed.Nonce,
+		PreviousServerSeed:     previous.ServerSeed,
+		PreviousServerSeedHash: previous.ServerSeedHash,
+	}, nil
+}
+
+func (u *paymentUsecase) OpenLootBox(pctx context.Context, cfg *config.Config, playerId string, req *payment.OpenLootBoxReq) ([]*payment.LootBoxDrawRes, error) {
+	sagaId := primitive.NewObjectID().Hex()
+
+	lootBox, err := u.paymentRepository.FindOneLootBox(pctx, cfg.Grpc.ItemUrl, &itemPb.FindOneLootBoxReq{
+		Id: req.LootBoxId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Stage 1: consume the loot box from the player's inventory
+	u.paymentRepository.RemovePlayerItem(pctx, cfg, &inventory.UpdateInventoryReq{
+		PlayerId: playerId,
+		ItemId:   req.LootBoxId,
+		SagaId:   sagaId,
+	})
+
+	resCh := make(chan *payment.PaymentTransferRes)
+
+	go u.BuyOrSellConsumer(pctx, "sell", cfg, resCh)
+
+	res := <-resCh
+	if res == nil || res.Error != "" {
+		return nil, errors.New("error: loot box not found in inventory")
+	}
+
+	rollbackLootBox := func() {
+		u.paymentRepository.RollbackRemovePlayerItem(pctx, cfg, &inventory.RollbackPlayerInventoryReq{
+			PlayerId: playerId,
+			ItemId:   req.LootBoxId,
+			SagaId:   sagaId,
+		})
+	}
+
+	// Stage 2: draw against the player's committed server seed
+	seed, err := u.paymentRepository.FindOrInsertLootBoxSeed(pctx, playerId)
+	if err != nil {
+		rollbackLootBox()
+		return nil, err
+	}
+
+	nonce, err := u.paymentRepository.ReserveLootBoxNonces(pctx, seed.Id, int64(lootBox.DrawCount))
+	if err != nil {
+		rollbackLootBox()
+		return nil, err
+	}
+
+	// The draws are counted up front, an open running at the same time starts after them
+	pity, err := u.paymentRepository.IncreaseLootBoxPity(pctx, playerId, lootBox.Id, int(lootBox.DrawCount))
+	if err != nil {
+		rollbackLootBox()
+		return nil, err
+	}
+	reserved := pity
+	lastRare := -1
+
+	draws := make([]*payment.LootBoxDraw, 0)
+	for i := int64(0); i < int64(lootBox.DrawCount); i++ {
+		roll := gacha.Roll(seed.ServerSeed, req.ClientSeed, nonce+i)
+		isPity := lootBox.PityThreshold > 0 && pity+1 >= int(lootBox.PityThreshold)
+
+		weights := make([]int, 0)
+		for _, d := range lootBox.Drops {
+			if isPity && !d.IsRare {
+				weights = append(weights, 0)
+				continue
+			}
+			weights = append(weights, int(d.Weight))
+		}
+
+		drop := lootBox.Drops[gacha.Pick(weights, roll)]
+		if drop.IsRare {
+			pity = 0
+			lastRare = int(i)
+		} else {
+			pity++
+		}
+
+		draws = append(draws, &payment.LootBoxDraw{
+			PlayerId:       playerId,
+			LootBoxId:      lootBox.Id,
+			ItemId:         drop.ItemId,
+			ServerSeedHash: seed.ServerSeedHash,
+			ClientSeed:     req.ClientSeed,
+			Nonce:          nonce + i,
+			Roll:           roll,
+			IsPity:         isPity,
+			CreatedAt:      utils.LocalTime(),
+		})
+	}
+
+	// Stage 3: grant the drops through the inventory buy path
+	granted := make([]*payment.LootBoxDraw, 0)
+	for _, d := range draws {
+		u.paymentRepository.AddPlayerItem(pctx, cfg, &inventory.UpdateInventoryReq{
+			PlayerId: playerId,
+			ItemId:   d.ItemId,
+			SagaId:   sagaId,
+		})
+
+		resCh := make(chan *payment.PaymentTransferRes)
+
+		go u.BuyOrSellConsumer(pctx, "buy", cfg, resCh)
+
+		res := <-resCh
+		if res == nil || res.Error != "" {
+			for _, g := range granted {
+				u.paymentRepository.RollbackAddPlayerItem(pctx, cfg, &inventory.RollbackPlayerInventoryReq{
+					InventoryId: g.InventoryId,
+					SagaId:      sagaId,
+				})
+			}
+			rollbackLootBox()
+			u.paymentRepository.IncreaseLootBoxPity(pctx, playerId, lootBox.Id, -int(lootBox.DrawCount))
+
+			if res != nil && res.Error == inventory.ErrInventoryFull.Error() {
+				return nil, inventory.ErrInventoryFull
+			}
+			return nil, errors.New("error: open loot box failed")
+		}
+
+		d.InventoryId = res.InventoryId
+		granted = append(granted, d)
+	}
+
+	// A rare drop clears what was counted up to it, draws counted since by other opens are kept
+	if lastRare >= 0 {
+		if _, err := u.paymentRepository.IncreaseLootBoxPity(pctx, playerId, lootBox.Id, -(reserved + lastRare + 1)); err != nil {
+			log.Printf("Error: OpenLootBox failed to save pity: %s", err.Error())
+		}
+	}
+	if err := u.paymentRepository.InsertManyLootBoxDraws(pctx, draws); err != nil {
+		log.Printf("Error: OpenLootBox failed to save draws: %s", err.Error())
+	}
+
+	results := make([]*payment.LootBoxDrawRes, 0)
+	for _, d := range draws {
+		results = append(results, &payment.LootBoxDrawRes{
+			ItemId:         d.ItemId,
+			InventoryId:    d.InventoryId,
+			ServerSeedHash: d.ServerSeedHash,
+			ClientSeed:     d.ClientSeed,
+			Nonce:          d.Nonce,
+			Roll:           d.Roll,
+			IsPity:         d.IsPity,
+		})
+	}
+
+	return results, nil
+}
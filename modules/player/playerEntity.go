@@ -15,6 +15,15 @@ type (
 		CreatedAt  time.Time          `json:"created_at" bson:"created_at"`
 		UpdatedAt  time.Time          `json:"updated_at" bson:"updated_at"`
 		PlayerRole []PlayerRole       `json:"player_role" bson:"player_role"`
+		Privacy    PlayerPrivacy      `json:"privacy" bson:"privacy"`
+		Showcase   []string           `json:"showcase,omitempty" bson:"showcase,omitempty"`
+	}
+
+	// PlayerPrivacy is all false by default, so a profile is public until the player hides parts of it
+	PlayerPrivacy struct {
+		Private      bool `json:"private" bson:"private"`
+		HideJoinDate bool `json:"hide_join_date" bson:"hide_join_date"`
+		HideShowcase bool `json:"hide_showcase" bson:"hide_showcase"`
 	}
 
 	PlayerRole struct {
@@ -26,6 +35,8 @@ type (
 		Id        primitive.ObjectID `json:"_id" bson:"_id,omitempty"`
 		Email     string             `json:"email" bson:"email"`
 		Username  string             `json:"username" bson:"username"`
+		Privacy   PlayerPrivacy      `json:"privacy" bson:"privacy"`
+		Showcase  []string           `json:"showcase" bson:"showcase"`
 		CreatedAt time.Time          `json:"created_at" bson:"created_at"`
 		UpdatedAt time.Time          `json:"updated_at" bson:"updated_at"`
 	}
@@ -31,3 +31,11 @@ func (g *playerGrpcHandler) FindOnePlayerProfileToRefresh(ctx context.Context, r
 func (g *playerGrpcHandler) GetPlayerSavingAccount(ctx context.Context, req *playerPb.GetPlayerSavingAccountReq) (*playerPb.GetPlayerSavingAccountRes, error) {
 	return nil, nil
 }
+
+func (g *playerGrpcHandler) CreatePlayerTransaction(ctx context.Context, req *playerPb.CreatePlayerTransactionReq) (*playerPb.CreatePlayerTransactionRes, error) {
+	return g.playerUsecase.InsertOnePlayerTransaction(ctx, req)
+}
+
+func (g *playerGrpcHandler) RollbackPlayerTransaction(ctx context.Context, req *playerPb.RollbackPlayerTransactionReq) (*playerPb.RollbackPlayerTransactionRes, error) {
+	return g.playerUsecase.DeleteOnePlayerTransaction(ctx, req)
+}
@@ -17,8 +17,11 @@ type (
 	PlayerHttpHandlerService interface {
 		CreatePlayer(c echo.Context) error
 		FindOnePlayerProfile(c echo.Context) error
+		FindPlayerPublicProfile(c echo.Context) error
 		AddPlayerMoney(c echo.Context) error
 		GetPlayerSavingAccount(c echo.Context) error
+		UpdatePrivacy(c echo.Context) error
+		UpdateShowcase(c echo.Context) error
 	}
 
 	playerHttpHandler struct {
@@ -66,6 +69,19 @@ func (h *playerHttpHandler) FindOnePlayerProfile(c echo.Context) error {
 	return response.SuccessResponse(c, http.StatusOK, res)
 }
 
+func (h *playerHttpHandler) FindPlayerPublicProfile(c echo.Context) error {
+	ctx := context.Background()
+
+	playerId := strings.TrimPrefix(c.Param("player_id"), "player:")
+
+	res, err := h.playerUsecase.FindPlayerPublicProfile(ctx, h.cfg, playerId)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, res)
+}
+
 func (h *playerHttpHandler) AddPlayerMoney(c echo.Context) error {
 	ctx := context.Background()
 
@@ -98,3 +114,45 @@ func (h *playerHttpHandler) GetPlayerSavingAccount(c echo.Context) error {
 
 	return response.SuccessResponse(c, http.StatusOK, res)
 }
+
+func (h *playerHttpHandler) UpdatePrivacy(c echo.Context) error {
+	ctx := context.Background()
+
+	wrapper := request.ContextWrapper(c)
+
+	req := new(player.UpdatePrivacyReq)
+	playerId := strings.TrimPrefix(c.Get("player_id").(string), "player:")
+
+	if err := wrapper.Bind(req); err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	res, err := h.playerUsecase.UpdatePrivacy(ctx, playerId, req)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, res)
+}
+
+func (h *playerHttpHandler) UpdateShowcase(c echo.Context) error {
+	ctx := context.Background()
+
+	wrapper := request.ContextWrapper(c)
+
+	req := &player.UpdateShowcaseReq{
+		InventoryIds: make([]string, 0),
+	}
+	playerId := strings.TrimPrefix(c.Get("player_id").(string), "player:")
+
+	if err := wrapper.Bind(req); err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	res, err := h.playerUsecase.UpdateShowcase(ctx, h.cfg, playerId, req)
+	if err != nil {
+		return response.ErrResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	return response.SuccessResponse(c, http.StatusOK, res)
+}
@@ -4,11 +4,41 @@ import "time"
 
 type (
 	PlayerProfile struct {
-		Id        string    `json:"_id"`
-		Email     string    `json:"email"`
-		Username  string    `json:"username"`
-		CreatedAt time.Time `json:"created_at"`
-		UpdatedAt time.Time `json:"updated_at"`
+		Id        string        `json:"_id"`
+		Email     string        `json:"email"`
+		Username  string        `json:"username"`
+		Privacy   PlayerPrivacy `json:"privacy"`
+		Showcase  []string      `json:"showcase"`
+		CreatedAt time.Time     `json:"created_at"`
+		UpdatedAt time.Time     `json:"updated_at"`
+	}
+
+	// PlayerPublicProfile is what other players see, the fields the owner hid are left out
+	PlayerPublicProfile struct {
+		Id       string          `json:"_id"`
+		Username string          `json:"username"`
+		Private  bool            `json:"private,omitempty"`
+		JoinedAt *time.Time      `json:"joined_at,omitempty"`
+		Showcase []*ShowcaseItem `json:"showcase,omitempty"`
+	}
+
+	ShowcaseItem struct {
+		InventoryId string `json:"inventory_id"`
+		ItemId      string `json:"item_id"`
+		Title       string `json:"title,omitempty"`
+		ImageUrl    string `json:"image_url,omitempty"`
+		Rarity      string `json:"rarity,omitempty"`
+		Damage      int    `json:"damage,omitempty"`
+	}
+
+	UpdatePrivacyReq struct {
+		Private      bool `json:"private"`
+		HideJoinDate bool `json:"hide_join_date"`
+		HideShowcase bool `json:"hide_showcase"`
+	}
+
+	UpdateShowcaseReq struct {
+		InventoryIds []string `json:"inventory_ids" validate:"max=50,dive,required,max=64"`
 	}
 
 	PlayerClaims struct {
@@ -25,6 +25,8 @@ type PlayerGrpcServiceClient interface {
 	CredentialSearch(ctx context.Context, in *CredentialSearchReq, opts ...grpc.CallOption) (*PlayerProfile, error)
 	FindOnePlayerProfileToRefresh(ctx context.Context, in *FindOnePlayerProfileToRefreshReq, opts ...grpc.CallOption) (*PlayerProfile, error)
 	GetPlayerSavingAccount(ctx context.Context, in *GetPlayerSavingAccountReq, opts ...grpc.CallOption) (*GetPlayerSavingAccountRes, error)
+	CreatePlayerTransaction(ctx context.Context, in *CreatePlayerTransactionReq, opts ...grpc.CallOption) (*CreatePlayerTransactionRes, error)
+	RollbackPlayerTransaction(ctx context.Context, in *RollbackPlayerTransactionReq, opts ...grpc.CallOption) (*RollbackPlayerTransactionRes, error)
 }
 
 type playerGrpcServiceClient struct {
@@ -62,6 +64,24 @@ func (c *playerGrpcServiceClient) GetPlayerSavingAccount(ctx context.Context, in
 	return out, nil
 }
 
+func (c *playerGrpcServiceClient) CreatePlayerTransaction(ctx context.Context, in *CreatePlayerTransactionReq, opts ...grpc.CallOption) (*CreatePlayerTransactionRes, error) {
+	out := new(CreatePlayerTransactionRes)
+	err := c.cc.Invoke(ctx, "/PlayerGrpcService/CreatePlayerTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *playerGrpcServiceClient) RollbackPlayerTransaction(ctx context.Context, in *RollbackPlayerTransactionReq, opts ...grpc.CallOption) (*RollbackPlayerTransactionRes, error) {
+	out := new(RollbackPlayerTransactionRes)
+	err := c.cc.Invoke(ctx, "/PlayerGrpcService/RollbackPlayerTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PlayerGrpcServiceServer is the server API for PlayerGrpcService service.
 // All implementations must embed UnimplementedPlayerGrpcServiceServer
 // for forward compatibility
@@ -69,6 +89,8 @@ type PlayerGrpcServiceServer interface {
 	CredentialSearch(context.Context, *CredentialSearchReq) (*PlayerProfile, error)
 	FindOnePlayerProfileToRefresh(context.Context, *FindOnePlayerProfileToRefreshReq) (*PlayerProfile, error)
 	GetPlayerSavingAccount(context.Context, *GetPlayerSavingAccountReq) (*GetPlayerSavingAccountRes, error)
+	CreatePlayerTransaction(context.Context, *CreatePlayerTransactionReq) (*CreatePlayerTransactionRes, error)
+	RollbackPlayerTransaction(context.Context, *RollbackPlayerTransactionReq) (*RollbackPlayerTransactionRes, error)
 	mustEmbedUnimplementedPlayerGrpcServiceServer()
 }
 
@@ -85,6 +107,12 @@ func (UnimplementedPlayerGrpcServiceServer) FindOnePlayerProfileToRefresh(contex
 func (UnimplementedPlayerGrpcServiceServer) GetPlayerSavingAccount(context.Context, *GetPlayerSavingAccountReq) (*GetPlayerSavingAccountRes, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPlayerSavingAccount not implemented")
 }
+func (UnimplementedPlayerGrpcServiceServer) CreatePlayerTransaction(context.Context, *CreatePlayerTransactionReq) (*CreatePlayerTransactionRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePlayerTransaction not implemented")
+}
+func (UnimplementedPlayerGrpcServiceServer) RollbackPlayerTransaction(context.Context, *RollbackPlayerTransactionReq) (*RollbackPlayerTransactionRes, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackPlayerTransaction not implemented")
+}
 func (UnimplementedPlayerGrpcServiceServer) mustEmbedUnimplementedPlayerGrpcServiceServer() {}
 
 // UnsafePlayerGrpcServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -152,6 +180,42 @@ func _PlayerGrpcService_GetPlayerSavingAccount_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PlayerGrpcService_CreatePlayerTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePlayerTransactionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerGrpcServiceServer).CreatePlayerTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/PlayerGrpcService/CreatePlayerTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerGrpcServiceServer).CreatePlayerTransaction(ctx, req.(*CreatePlayerTransactionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlayerGrpcService_RollbackPlayerTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackPlayerTransactionReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlayerGrpcServiceServer).RollbackPlayerTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/PlayerGrpcService/RollbackPlayerTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlayerGrpcServiceServer).RollbackPlayerTransaction(ctx, req.(*RollbackPlayerTransactionReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PlayerGrpcService_ServiceDesc is the grpc.ServiceDesc for PlayerGrpcService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -171,6 +235,14 @@ var PlayerGrpcService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetPlayerSavingAccount",
 			Handler:    _PlayerGrpcService_GetPlayerSavingAccount_Handler,
 		},
+		{
+			MethodName: "CreatePlayerTransaction",
+			Handler:    _PlayerGrpcService_CreatePlayerTransaction_Handler,
+		},
+		{
+			MethodName: "RollbackPlayerTransaction",
+			Handler:    _PlayerGrpcService_RollbackPlayerTransaction_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "modules/player/playerPb/playerPb.proto",
@@ -8,9 +8,13 @@ import (
 	"time"
 
 	"github.com/Applessr/hello-sekai-shop-tutorial/config"
+	inventoryPb "github.com/Applessr/hello-sekai-shop-tutorial/modules/inventory/inventoryPb"
+	itemPb "github.com/Applessr/hello-sekai-shop-tutorial/modules/item/itemPb"
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/models"
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/payment"
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/player"
+	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/grpccon"
+	jwtAuth "github.com/Applessr/hello-sekai-shop-tutorial/pkg/jwtauth"
 	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/queue"
 	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/utils"
 	"go.mongodb.org/mongo-driver/bson"
@@ -26,6 +30,9 @@ type (
 		IsUniquePlayer(pctx context.Context, email, username string) bool
 		InsertOnePlayer(pctx context.Context, req *player.Player) (primitive.ObjectID, error)
 		FindOnePlayerProfile(pctx context.Context, id string) (*player.PlayerProfileBson, error)
+		UpdateOnePlayer(pctx context.Context, playerId string, req primitive.M) error
+		FindInventoryEntries(pctx context.Context, grpcUrl string, req *inventoryPb.GetInventoryEntriesReq) (*inventoryPb.GetInventoryEntriesRes, error)
+		FindItemInIds(pctx context.Context, grpcUrl string, req *itemPb.FindItemInIdsReq) (*itemPb.FindItemInIdsRes, error)
 		InsertOnePlayerTransaction(pctx context.Context, req *player.PlayerTransaction) (primitive.ObjectID, error)
 		GetPlayerSavingAccount(pctx context.Context, playerId string) (*player.PlayerSavingAccount, error)
 		FindOnePlayerCredential(pctx context.Context, email string) (*player.Player, error)
@@ -134,6 +141,8 @@ func (r *playerRepository) FindOnePlayerProfile(pctx context.Context, id string)
 			"_id":        1,
 			"email":      1,
 			"username":   1,
+			"privacy":    1,
+			"showcase":   1,
 			"created_at": 1,
 			"updated_at": 1,
 		}),
@@ -145,6 +154,65 @@ func (r *playerRepository) FindOnePlayerProfile(pctx context.Context, id string)
 	return result, nil
 }
 
+func (r *playerRepository) UpdateOnePlayer(pctx context.Context, playerId string, req primitive.M) error {
+	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
+	defer cancel()
+
+	db := r.playerDbConnect(ctx)
+	col := db.Collection("players")
+
+	result, err := col.UpdateOne(ctx, bson.M{"_id": utils.ConvertToObjectId(playerId)}, bson.M{"$set": req})
+	if err != nil {
+		log.Printf("Error: UpdateOnePlayer failed: %s", err.Error())
+		return errors.New("error: update one player failed")
+	}
+	if result.MatchedCount == 0 {
+		return errors.New("error: player not found")
+	}
+
+	return nil
+}
+
+func (r *playerRepository) FindInventoryEntries(pctx context.Context, grpcUrl string, req *inventoryPb.GetInventoryEntriesReq) (*inventoryPb.GetInventoryEntriesRes, error) {
+	ctx, cancel := context.WithTimeout(pctx, 30*time.Second)
+	defer cancel()
+
+	jwtAuth.SetApiKeyInContext(&ctx)
+	conn, err := grpccon.NewGrpcClient(grpcUrl)
+	if err != nil {
+		log.Printf("Error: gRPC connection failed: %s", err.Error())
+		return nil, errors.New("error: gRPC connection failed")
+	}
+
+	result, err := conn.Inventory().GetInventoryEntries(ctx, req)
+	if err != nil {
+		log.Printf("Error: FindInventoryEntries failed: %s", err.Error())
+		return nil, errors.New("error: find inventory entries failed")
+	}
+
+	return result, nil
+}
+
+func (r *playerRepository) FindItemInIds(pctx context.Context, grpcUrl string, req *itemPb.FindItemInIdsReq) (*itemPb.FindItemInIdsRes, error) {
+	ctx, cancel := context.WithTimeout(pctx, 30*time.Second)
+	defer cancel()
+
+	jwtAuth.SetApiKeyInContext(&ctx)
+	conn, err := grpccon.NewGrpcClient(grpcUrl)
+	if err != nil {
+		log.Printf("Error: gRPC connection failed: %s", err.Error())
+		return nil, errors.New("error: gRPC connection failed")
+	}
+
+	result, err := conn.Item().FindItemInIds(ctx, req)
+	if err != nil {
+		log.Printf("Error: FindItemInIds failed: %s", err.Error())
+		return nil, errors.New("error: items not found")
+	}
+
+	return result, nil
+}
+
 func (r *playerRepository) InsertOnePlayerTransaction(pctx context.Context, req *player.PlayerTransaction) (primitive.ObjectID, error) {
 	ctx, cancel := context.WithTimeout(pctx, 10*time.Second)
 	defer cancel()
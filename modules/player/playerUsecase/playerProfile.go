@@ -0,0 +1,146 @@
+package playerUsecase
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/Applessr/hello-sekai-shop-tutorial/config"
+	inventoryPb "github.com/Applessr/hello-sekai-shop-tutorial/modules/inventory/inventoryPb"
+	itemPb "github.com/Applessr/hello-sekai-shop-tutorial/modules/item/itemPb"
+	"github.com/Applessr/hello-sekai-shop-tutorial/modules/player"
+	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/utils"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func (u *playerUsecase) FindPlayerPublicProfile(pctx context.Context, cfg *config.Config, playerId string) (*player.PlayerPublicProfile, error) {
+	result, err := u.playerRepository.FindOnePlayerProfile(pctx, playerId)
+	if err != nil {
+		return nil, errors.New("error: find one player profile not found")
+	}
+
+	profile := &player.PlayerPublicProfile{
+		Id:       result.Id.Hex(),
+		Username: result.Username,
+	}
+	if result.Privacy.Private {
+		profile.Private = true
+		return profile, nil
+	}
+
+	if !result.Privacy.HideJoinDate {
+		loc, _ := time.LoadLocation("Asia/Bangkok")
+		joinedAt := result.CreatedAt.In(loc)
+		profile.JoinedAt = &joinedAt
+	}
+	if !result.Privacy.HideShowcase {
+		profile.Showcase = u.findShowcase(pctx, cfg, result.Id.Hex(), result.Showcase)
+	}
+
+	return profile, nil
+}
+
+// findShowcase resolves the showcased entries the player still owns and that have not expired,
+// the profile is shown without the showcase or its item details when a service can't answer
+func (u *playerUsecase) findShowcase(pctx context.Context, cfg *config.Config, playerId string, inventoryIds []string) []*player.ShowcaseItem {
+	results := make([]*player.ShowcaseItem, 0)
+	if len(inventoryIds) == 0 {
+		return results
+	}
+
+	entries, err := u.playerRepository.FindInventoryEntries(pctx, cfg.Grpc.InventoryUrl, &inventoryPb.GetInventoryEntriesReq{
+		PlayerId:     "player:" + playerId,
+		InventoryIds: inventoryIds,
+	})
+	if err != nil {
+		log.Printf("Error: findShowcase failed: %s", err.Error())
+		return results
+	}
+
+	itemIds := make([]string, 0)
+	for _, entry := range entries.Entries {
+		results = append(results, &player.ShowcaseItem{
+			InventoryId: entry.Id,
+			ItemId:      entry.ItemId,
+		})
+		itemIds = append(itemIds, entry.ItemId)
+	}
+	if len(itemIds) == 0 {
+		return results
+	}
+
+	itemData, err := u.playerRepository.FindItemInIds(pctx, cfg.Grpc.ItemUrl, &itemPb.FindItemInIdsReq{Ids: itemIds})
+	if err != nil {
+		log.Printf("Error: findShowcase failed: %s", err.Error())
+		return results
+	}
+	itemMaps := make(map[string]*itemPb.Item)
+	for _, v := range itemData.Items {
+		itemMaps[v.Id] = v
+	}
+	for _, v := range results {
+		if itemData, ok := itemMaps[v.ItemId]; ok {
+			v.Title = itemData.Title
+			v.ImageUrl = itemData.ImageUrl
+			v.Rarity = itemData.Rarity
+			v.Damage = int(itemData.Damage)
+		}
+	}
+
+	return results
+}
+
+func (u *playerUsecase) UpdatePrivacy(pctx context.Context, playerId string, req *player.UpdatePrivacyReq) (*player.PlayerProfile, error) {
+	if err := u.playerRepository.UpdateOnePlayer(pctx, playerId, bson.M{
+		"privacy": player.PlayerPrivacy{
+			Private:      req.Private,
+			HideJoinDate: req.HideJoinDate,
+			HideShowcase: req.HideShowcase,
+		},
+		"updated_at": utils.LocalTime(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return u.FindOnePlayerProfile(pctx, playerId)
+}
+
+// UpdateShowcase replaces the showcase, every entry has to be in the player's inventory and not expired
+func (u *playerUsecase) UpdateShowcase(pctx context.Context, cfg *config.Config, playerId string, req *player.UpdateShowcaseReq) (*player.PlayerProfile, error) {
+	if len(req.InventoryIds) > cfg.Player.ShowcaseSize {
+		return nil, errors.New("error: too many items in the showcase")
+	}
+
+	showcase := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, inventoryId := range req.InventoryIds {
+		if seen[inventoryId] {
+			return nil, errors.New("error: item is already in the showcase")
+		}
+		seen[inventoryId] = true
+		showcase = append(showcase, inventoryId)
+	}
+
+	if len(showcase) > 0 {
+		entries, err := u.playerRepository.FindInventoryEntries(pctx, cfg.Grpc.InventoryUrl, &inventoryPb.GetInventoryEntriesReq{
+			PlayerId:     "player:" + playerId,
+			InventoryIds: showcase,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if len(entries.Entries) != len(showcase) {
+			return nil, errors.New("error: inventory entry not found")
+		}
+	}
+
+	if err := u.playerRepository.UpdateOnePlayer(pctx, playerId, bson.M{
+		"showcase":   showcase,
+		"updated_at": utils.LocalTime(),
+	}); err != nil {
+		return nil, err
+	}
+
+	return u.FindOnePlayerProfile(pctx, playerId)
+}
@@ -22,6 +22,9 @@ type (
 		UpserOffset(pctx context.Context, offset int64) error
 		CreatePlayer(pctx context.Context, req *player.CreatePlayerReq) (*player.PlayerProfile, error)
 		FindOnePlayerProfile(pctx context.Context, playerId string) (*player.PlayerProfile, error)
+		FindPlayerPublicProfile(pctx context.Context, cfg *config.Config, playerId string) (*player.PlayerPublicProfile, error)
+		UpdatePrivacy(pctx context.Context, playerId string, req *player.UpdatePrivacyReq) (*player.PlayerProfile, error)
+		UpdateShowcase(pctx context.Context, cfg *config.Config, playerId string, req *player.UpdateShowcaseReq) (*player.PlayerProfile, error)
 		AddPlayerMoney(pctx context.Context, req *player.CreatePlayerTransactionReq) (*player.PlayerSavingAccount, error)
 		GetPlayerSavingAccount(pctx context.Context, playerId string) (*player.PlayerSavingAccount, error)
 		FindOnePlayerCredential(pctx context.Context, password, email string) (*playerPb.PlayerProfile, error)
@@ -29,6 +32,8 @@ type (
 		RollbackPlayerTransaction(pctx context.Context, req *player.RollbackPlayerTransactionReq)
 		DockedPlayerMoneyRes(pctx context.Context, cfg *config.Config, req *player.CreatePlayerTransactionReq)
 		AddPlayerMoneyRes(pctx context.Context, cfg *config.Config, req *player.CreatePlayerTransactionReq)
+		InsertOnePlayerTransaction(pctx context.Context, req *playerPb.CreatePlayerTransactionReq) (*playerPb.CreatePlayerTransactionRes, error)
+		DeleteOnePlayerTransaction(pctx context.Context, req *playerPb.RollbackPlayerTransactionReq) (*playerPb.RollbackPlayerTransactionRes, error)
 	}
 
 	playerUsecase struct {
@@ -87,10 +92,17 @@ func (u *playerUsecase) FindOnePlayerProfile(pctx context.Context, playerId stri
 
 	loc, _ := time.LoadLocation("Asia/Bangkok")
 
+	showcase := result.Showcase
+	if showcase == nil {
+		showcase = make([]string, 0)
+	}
+
 	return &player.PlayerProfile{
 		Id:        result.Id.Hex(),
 		Email:     result.Email,
 		Username:  result.Username,
+		Privacy:   result.Privacy,
+		Showcase:  showcase,
 		CreatedAt: result.CreatedAt.In(loc),
 		UpdatedAt: result.UpdatedAt.In(loc),
 	}, nil
@@ -256,3 +268,42 @@ func (u *playerUsecase) AddPlayerMoneyRes(pctx context.Context, cfg *config.Conf
 		Error:         "",
 	})
 }
+
+func (u *playerUsecase) InsertOnePlayerTransaction(pctx context.Context, req *playerPb.CreatePlayerTransactionReq) (*playerPb.CreatePlayerTransactionRes, error) {
+	if req.Amount < 0 {
+		savingAccount, err := u.playerRepository.GetPlayerSavingAccount(pctx, req.PlayerId)
+		if err != nil {
+			return nil, err
+		}
+
+		if savingAccount.Balance < math.Abs(req.Amount) {
+			log.Printf("Error: InsertOnePlayerTransaction failed: %s", "not enough money")
+			return nil, errors.New("error: not enough money")
+		}
+	}
+
+	transactionId, err := u.playerRepository.InsertOnePlayerTransaction(pctx, &player.PlayerTransaction{
+		PlayerId:  req.PlayerId,
+		Amount:    req.Amount,
+		CreatedAt: utils.LocalTime(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &playerPb.CreatePlayerTransactionRes{
+		TransactionId: transactionId.Hex(),
+	}, nil
+}
+
+func (u *playerUsecase) DeleteOnePlayerTransaction(pctx context.Context, req *playerPb.RollbackPlayerTransactionReq) (*playerPb.RollbackPlayerTransactionRes, error) {
+	if err := u.playerRepository.DeleteOnePlayerTransaction(pctx, req.TransactionId); err != nil {
+		return &playerPb.RollbackPlayerTransactionRes{
+			IsSuccess: false,
+		}, err
+	}
+
+	return &playerPb.RollbackPlayerTransactionRes{
+		IsSuccess: true,
+	}, nil
+}
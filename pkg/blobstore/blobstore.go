@@ -0,0 +1,26 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/Applessr/hello-sekai-shop-tutorial/config"
+)
+
+var ErrNotFound = errors.New("error: blob not found")
+
+type (
+	BlobStoreService interface {
+		Put(pctx context.Context, key, contentType string, data []byte) error
+		Get(pctx context.Context, key string) (io.ReadCloser, string, error)
+	}
+)
+
+func NewBlobStore(cfg *config.Blob) (BlobStoreService, error) {
+	switch cfg.Driver {
+	case "", "local":
+		return NewLocalBlobStore(cfg.LocalDir), nil
+	}
+	return nil, errors.New("error: unknown blob driver: " + cfg.Driver)
+}
@@ -0,0 +1,77 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"mime"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type localBlobStore struct {
+	dir string
+}
+
+func NewLocalBlobStore(dir string) BlobStoreService {
+	return &localBlobStore{dir}
+}
+
+func (s *localBlobStore) path(key string) (string, error) {
+	clean := filepath.Clean("/" + key)
+	if key == "" || clean != "/"+key || strings.Contains(key, "..") {
+		return "", errors.New("error: invalid blob key")
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(clean)), nil
+}
+
+func (s *localBlobStore) Put(pctx context.Context, key, contentType string, data []byte) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		log.Printf("Error: Put blob failed: %s", err.Error())
+		return errors.New("error: put blob failed")
+	}
+
+	// write then rename so a reader never sees a partial file
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		log.Printf("Error: Put blob failed: %s", err.Error())
+		return errors.New("error: put blob failed")
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		os.Remove(tmp)
+		log.Printf("Error: Put blob failed: %s", err.Error())
+		return errors.New("error: put blob failed")
+	}
+
+	return nil
+}
+
+func (s *localBlobStore) Get(pctx context.Context, key string) (io.ReadCloser, string, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, "", err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, "", ErrNotFound
+		}
+		log.Printf("Error: Get blob failed: %s", err.Error())
+		return nil, "", errors.New("error: get blob failed")
+	}
+
+	contentType := mime.TypeByExtension(filepath.Ext(path))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+
+	return file, contentType, nil
+}
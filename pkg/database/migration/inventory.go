@@ -3,11 +3,18 @@ package migration
 import (
 	"context"
 	"log"
+	"strings"
 
 	"github.com/Applessr/hello-sekai-shop-tutorial/config"
+	"github.com/Applessr/hello-sekai-shop-tutorial/modules/inventory"
+	itemPb "github.com/Applessr/hello-sekai-shop-tutorial/modules/item/itemPb"
 	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/database"
+	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/grpccon"
+	jwtAuth "github.com/Applessr/hello-sekai-shop-tutorial/pkg/jwtauth"
 	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 func inventoryDbConn(pctx context.Context, cfg *config.Config) *mongo.Database {
@@ -22,6 +29,123 @@ func InventoryMigrate(pctx context.Context, cfg *config.Config) {
 
 	index, _ := col.Indexes().CreateMany(pctx, []mongo.IndexModel{
 		{Keys: bson.D{{"_id", 1}, {"item_id", 1}}},
+		{Keys: bson.D{{"player_id", 1}, {"item_id", 1}}},
+		{Keys: bson.D{{"item_id", 1}, {"item.version", 1}}},
+		{Keys: bson.D{{"player_id", 1}, {"item.category", 1}}},
+		{Keys: bson.D{{"player_id", 1}, {"item.rarity", 1}}},
+		{Keys: bson.D{{"player_id", 1}, {"item.price", 1}, {"_id", 1}}},
+		{Keys: bson.D{{"player_id", 1}, {"item.damage", 1}, {"_id", 1}}},
+		{
+			Keys:    bson.D{{"player_id", 1}, {"equipped_slot", 1}},
+			Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{"equipped_slot": bson.M{"$exists": true}}),
+		},
+		{
+			Keys:    bson.D{{"expires_at", 1}},
+			Options: options.Index().SetPartialFilterExpression(bson.M{"expires_at": bson.M{"$exists": true}}),
+		},
+	})
+	for _, index := range index {
+		log.Printf("index: %s", index)
+	}
+
+	// Entries from before stacking hold a single unit
+	updated, err := col.UpdateMany(pctx, bson.M{"quantity": bson.M{"$exists": false}}, bson.M{"$set": bson.M{"quantity": 1}})
+	if err != nil {
+		panic(err)
+	}
+	log.Printf("backfill quantity: %d", updated.ModifiedCount)
+
+	col = db.Collection("players_inventory_capacity")
+
+	index, _ = col.Indexes().CreateMany(pctx, []mongo.IndexModel{
+		{Keys: bson.D{{"player_id", 1}}, Options: options.Index().SetUnique(true)},
+	})
+	for _, index := range index {
+		log.Printf("index: %s", index)
+	}
+
+	col = db.Collection("inventory_events")
+
+	index, _ = col.Indexes().CreateMany(pctx, []mongo.IndexModel{
+		{Keys: bson.D{{"player_id", 1}, {"_id", -1}}},
+		{Keys: bson.D{{"player_id", 1}, {"occurred_at", 1}}},
+		{Keys: bson.D{{"source", 1}, {"source_id", 1}}},
+		{
+			Keys:    bson.D{{"source_id", 1}},
+			Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{"source": "admin_job"}),
+		},
+	})
+	for _, index := range index {
+		log.Printf("index: %s", index)
+	}
+
+	// Entries from before the history started are recorded as added when they were created,
+	// per player so a player without history is still backfilled once others have events
+	playerIds, err := db.Collection("players_inventory").Distinct(pctx, "player_id", bson.M{})
+	if err != nil {
+		panic(err)
+	}
+	backfilled := 0
+	for _, playerId := range playerIds {
+		if count, _ := col.CountDocuments(pctx, bson.M{"player_id": playerId}); count > 0 {
+			continue
+		}
+
+		cursors, err := db.Collection("players_inventory").Find(pctx, bson.M{"player_id": playerId})
+		if err != nil {
+			panic(err)
+		}
+
+		events := make([]any, 0)
+		for cursors.Next(pctx) {
+			entry := new(inventory.Inventory)
+			if err := cursors.Decode(entry); err != nil {
+				panic(err)
+			}
+
+			events = append(events, &inventory.InventoryEvent{
+				EventId:     primitive.NewObjectIDFromTimestamp(entry.Id.Timestamp()).Hex(),
+				Type:        "inventory.added",
+				PlayerId:    entry.PlayerId,
+				InventoryId: entry.Id.Hex(),
+				ItemId:      entry.ItemId,
+				Quantity:    entry.Quantity,
+				Source:      "migration",
+				OccurredAt:  entry.Id.Timestamp(),
+			})
+		}
+		if len(events) > 0 {
+			if _, err := col.InsertMany(pctx, events); err != nil {
+				panic(err)
+			}
+		}
+		backfilled += len(events)
+	}
+	log.Printf("backfill inventory events: %d", backfilled)
+
+	col = db.Collection("inventory_admin_jobs")
+
+	index, _ = col.Indexes().CreateMany(pctx, []mongo.IndexModel{
+		{Keys: bson.D{{"status", 1}, {"created_at", 1}}},
+	})
+	for _, index := range index {
+		log.Printf("index: %s", index)
+	}
+
+	col = db.Collection("players_crafts")
+
+	index, _ = col.Indexes().CreateMany(pctx, []mongo.IndexModel{
+		{Keys: bson.D{{"status", 1}, {"updated_at", 1}}},
+	})
+	for _, index := range index {
+		log.Printf("index: %s", index)
+	}
+
+	col = db.Collection("players_trades")
+
+	index, _ = col.Indexes().CreateMany(pctx, []mongo.IndexModel{
+		{Keys: bson.D{{"status", 1}, {"expires_at", 1}}},
+		{Keys: bson.D{{"status", 1}, {"updated_at", 1}}},
 	})
 	for _, index := range index {
 		log.Printf("index: %s", index)
@@ -35,3 +159,94 @@ func InventoryMigrate(pctx context.Context, cfg *config.Config) {
 	}
 	log.Println("Migrate inventory completed: ", results)
 }
+
+// InventoryMergeStacks folds duplicate unlocked entries of stackable items into as few stacks as
+// their max stack allows, stack sizes come from the item service so it has to be running
+func InventoryMergeStacks(pctx context.Context, cfg *config.Config) {
+	db := inventoryDbConn(pctx, cfg)
+	defer db.Client().Disconnect(pctx)
+
+	col := db.Collection("players_inventory")
+
+	itemIds, err := col.Distinct(pctx, "item_id", bson.M{})
+	if err != nil {
+		panic(err)
+	}
+
+	req := &itemPb.FindItemInIdsReq{Ids: make([]string, 0)}
+	for _, v := range itemIds {
+		if itemId, ok := v.(string); ok {
+			req.Ids = append(req.Ids, itemId)
+		}
+	}
+
+	jwtAuth.SetApiKey(cfg.Jwt.ApiSecretKey)
+	ctx := pctx
+	jwtAuth.SetApiKeyInContext(&ctx)
+	conn, err := grpccon.NewGrpcClient(cfg.Grpc.ItemUrl)
+	if err != nil {
+		panic(err)
+	}
+	items, err := conn.Item().FindItemInIds(ctx, req)
+	if err != nil {
+		panic(err)
+	}
+
+	maxStacks := make(map[string]int64)
+	for _, v := range items.Items {
+		if v.Stackable && v.MaxStack > 1 {
+			maxStacks[strings.TrimPrefix(v.Id, "item:")] = int64(v.MaxStack)
+		}
+	}
+
+	for _, itemId := range req.Ids {
+		maxStack, ok := maxStacks[strings.TrimPrefix(itemId, "item:")]
+		if !ok {
+			continue
+		}
+
+		cursors, err := col.Aggregate(pctx, mongo.Pipeline{
+			{{"$match", bson.D{{"item_id", itemId}, {"locked_by", bson.M{"$exists": false}}}}},
+			{{"$sort", bson.D{{"_id", 1}}}},
+			{{"$group", bson.D{
+				{"_id", "$player_id"},
+				{"ids", bson.M{"$push": "$_id"}},
+				{"total", bson.M{"$sum": bson.M{"$ifNull": bson.A{"$quantity", 1}}}},
+			}}},
+			{{"$match", bson.D{{"ids.1", bson.M{"$exists": true}}}}},
+		})
+		if err != nil {
+			panic(err)
+		}
+
+		merged := 0
+		for cursors.Next(pctx) {
+			group := new(struct {
+				PlayerId string               `bson:"_id"`
+				Ids      []primitive.ObjectID `bson:"ids"`
+				Total    int64                `bson:"total"`
+			})
+			if err := cursors.Decode(group); err != nil {
+				panic(err)
+			}
+
+			// The oldest entries are kept and filled up, whatever is left over is removed
+			remaining := group.Total
+			for i, id := range group.Ids {
+				if remaining <= 0 {
+					if _, err := col.DeleteMany(pctx, bson.M{"_id": bson.M{"$in": group.Ids[i:]}}); err != nil {
+						panic(err)
+					}
+					break
+				}
+				quantity := min(remaining, maxStack)
+				if _, err := col.UpdateOne(pctx, bson.M{"_id": id}, bson.M{"$set": bson.M{"quantity": quantity}}); err != nil {
+					panic(err)
+				}
+				remaining -= quantity
+			}
+			merged++
+		}
+		log.Printf("merge stacks %s: %d players", itemId, merged)
+	}
+}
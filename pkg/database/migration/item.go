@@ -10,6 +10,7 @@ import (
 	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/utils"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 func itemDbConn(pctx context.Context, cfg *config.Config) *mongo.Database {
@@ -25,16 +26,60 @@ func ItemMigrate(pctx context.Context, cfg *config.Config) {
 	index, _ := col.Indexes().CreateMany(pctx, []mongo.IndexModel{
 		{Keys: bson.D{{"_id", 1}}},
 		{Keys: bson.D{{"title", 1}}},
+		{Keys: bson.D{{"title_lower", 1}, {"usage_status", 1}}},
+		{
+			Keys:    bson.D{{"title", "text"}, {"description", "text"}, {"tags", "text"}},
+			Options: options.Index().SetName("item_text").SetWeights(bson.D{{"title", 10}, {"tags", 5}, {"description", 1}}),
+		},
+		{Keys: bson.D{{"usage_status", 1}, {"category", 1}, {"price", 1}}},
+		{Keys: bson.D{{"usage_status", 1}, {"category", 1}, {"damage", 1}}},
+		{Keys: bson.D{{"usage_status", 1}, {"rarity", 1}, {"price", 1}}},
+		{Keys: bson.D{{"usage_status", 1}, {"tags", 1}}},
+		{Keys: bson.D{{"usage_status", 1}, {"price", 1}, {"_id", 1}}},
+		{Keys: bson.D{{"usage_status", 1}, {"damage", 1}, {"_id", 1}}},
+		{Keys: bson.D{{"usage_status", 1}, {"created_at", -1}, {"_id", -1}}},
 	})
 	for _, index := range index {
 		log.Printf("index: %s", index)
 	}
 
+	// item_revisions
+	revisionIndex, _ := db.Collection("item_revisions").Indexes().CreateMany(pctx, []mongo.IndexModel{
+		{Keys: bson.D{{"item_id", 1}, {"created_at", -1}}},
+	})
+	for _, index := range revisionIndex {
+		log.Printf("index: %s", index)
+	}
+
+	// recipes
+	recipeIndex, _ := db.Collection("recipes").Indexes().CreateMany(pctx, []mongo.IndexModel{
+		{Keys: bson.D{{"usage_status", 1}, {"title", 1}}},
+	})
+	for _, index := range recipeIndex {
+		log.Printf("index: %s", index)
+	}
+
+	// storefront_rules
+	storefrontIndex, _ := db.Collection("storefront_rules").Indexes().CreateMany(pctx, []mongo.IndexModel{
+		{Keys: bson.D{{"usage_status", 1}}},
+	})
+	for _, index := range storefrontIndex {
+		log.Printf("index: %s", index)
+	}
+
+	// backfill the lowercased title used by suggestions
+	if _, err := col.UpdateMany(pctx, bson.M{"title_lower": bson.M{"$exists": false}}, mongo.Pipeline{
+		{{"$set", bson.D{{"title_lower", bson.D{{"$toLower", "$title"}}}}}},
+	}); err != nil {
+		log.Printf("Error: backfill title_lower failed: %s", err.Error())
+	}
+
 	//roles
 	documents := func() []any {
 		roles := []*item.Item{
 			{
 				Title:       "Diamond Sword",
+				TitleLower:  "diamond sword",
 				Price:       1000,
 				ImageUrl:    "https://i.imgur.com/1Y8tQZM.png",
 				UsageStatus: true,
@@ -44,6 +89,7 @@ func ItemMigrate(pctx context.Context, cfg *config.Config) {
 			},
 			{
 				Title:       "Iron Sword",
+				TitleLower:  "iron sword",
 				Price:       500,
 				ImageUrl:    "https://i.imgur.com/1Y8tQZM.png",
 				UsageStatus: true,
@@ -53,6 +99,7 @@ func ItemMigrate(pctx context.Context, cfg *config.Config) {
 			},
 			{
 				Title:       "Wooden Sword",
+				TitleLower:  "wooden sword",
 				Price:       100,
 				ImageUrl:    "https://i.imgur.com/1Y8tQZM.png",
 				UsageStatus: true,
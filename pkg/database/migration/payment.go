@@ -8,6 +8,7 @@ import (
 	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/database"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 func paymentDbConn(pctx context.Context, cfg *config.Config) *mongo.Database {
@@ -18,7 +19,51 @@ func PaymentMigrate(pctx context.Context, cfg *config.Config) {
 	db := paymentDbConn(pctx, cfg)
 	defer db.Client().Disconnect(pctx)
 
-	col := db.Collection("payment_queue")
+	col := db.Collection("loot_box_seeds")
+
+	index, _ := col.Indexes().CreateMany(pctx, []mongo.IndexModel{
+		{Keys: bson.D{{"player_id", 1}, {"is_active", 1}}},
+		{
+			Keys:    bson.D{{"player_id", 1}},
+			Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{"is_active": true}),
+		},
+	})
+	for _, index := range index {
+		log.Printf("index: %s", index)
+	}
+
+	col = db.Collection("loot_box_pity")
+
+	index, _ = col.Indexes().CreateMany(pctx, []mongo.IndexModel{
+		{Keys: bson.D{{"player_id", 1}, {"loot_box_id", 1}}, Options: options.Index().SetUnique(true)},
+	})
+	for _, index := range index {
+		log.Printf("index: %s", index)
+	}
+
+	col = db.Collection("loot_box_draws")
+
+	index, _ = col.Indexes().CreateMany(pctx, []mongo.IndexModel{
+		{Keys: bson.D{{"player_id", 1}, {"created_at", -1}}},
+	})
+	for _, index := range index {
+		log.Printf("index: %s", index)
+	}
+
+	col = db.Collection("payment_orders")
+
+	index, _ = col.Indexes().CreateMany(pctx, []mongo.IndexModel{
+		{Keys: bson.D{{"player_id", 1}, {"_id", -1}}},
+		{
+			Keys:    bson.D{{"player_id", 1}, {"request_id", 1}},
+			Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{"request_id": bson.M{"$exists": true}}),
+		},
+	})
+	for _, index := range index {
+		log.Printf("index: %s", index)
+	}
+
+	col = db.Collection("payment_queue")
 
 	results, err := col.InsertOne(pctx, bson.M{"offset": -1}, nil)
 	if err != nil {
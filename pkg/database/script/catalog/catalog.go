@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/Applessr/hello-sekai-shop-tutorial/config"
+	"github.com/Applessr/hello-sekai-shop-tutorial/modules/item"
+	"github.com/Applessr/hello-sekai-shop-tutorial/modules/item/itemRepository"
+	"github.com/Applessr/hello-sekai-shop-tutorial/modules/item/itemUsecase"
+	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/blobstore"
+	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/database"
+)
+
+// go run ./pkg/database/script/catalog ./env/dev/.env.item import items.csv [-upsert] [-dry-run]
+// go run ./pkg/database/script/catalog ./env/dev/.env.item export items.csv
+func main() {
+	ctx := context.Background()
+
+	if len(os.Args) < 4 {
+		log.Fatal("Error: usage: catalog <.env path> <import|export> <file> [-upsert] [-dry-run]")
+	}
+
+	cfg := config.LoadConfig(os.Args[1])
+
+	flags := flag.NewFlagSet("catalog", flag.ExitOnError)
+	upsert := flags.Bool("upsert", false, "update existing items matched by title")
+	dryRun := flags.Bool("dry-run", false, "report the changes without writing them")
+	flags.Parse(os.Args[4:])
+
+	path := os.Args[3]
+	format := strings.TrimPrefix(filepath.Ext(path), ".")
+
+	db := database.DbConnect(ctx, &cfg)
+	defer db.Disconnect(ctx)
+
+	blobStore, err := blobstore.NewBlobStore(&cfg.Blob)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	usecase := itemUsecase.NewItemUsecase(itemRepository.NewItemRepository(db), blobStore)
+
+	switch os.Args[2] {
+	case "import":
+		file, err := os.Open(path)
+		if err != nil {
+			log.Fatalf("Error: open %s failed: %s", path, err.Error())
+		}
+		defer file.Close()
+
+		rows, err := item.DecodeCatalog(format, file)
+		if err != nil {
+			log.Fatal(err.Error())
+		}
+
+		res, err := usecase.ImportItems(ctx, &cfg, "cli", rows, *upsert, *dryRun)
+		if err != nil {
+			log.Fatal(err.Error())
+		}
+
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		encoder.Encode(res)
+	case "export":
+		rows, err := usecase.ExportItems(ctx)
+		if err != nil {
+			log.Fatal(err.Error())
+		}
+
+		file, err := os.Create(path)
+		if err != nil {
+			log.Fatalf("Error: create %s failed: %s", path, err.Error())
+		}
+		defer file.Close()
+
+		if err := item.EncodeCatalog(format, file, rows); err != nil {
+			log.Fatal(err.Error())
+		}
+		log.Printf("Exported %d items to %s", len(rows), path)
+	default:
+		log.Fatal("Error: command must be import or export")
+	}
+}
@@ -25,6 +25,10 @@ func main() {
 	case "item":
 		migration.ItemMigrate(ctx, &cfg)
 	case "inventory":
+		if len(os.Args) > 2 && os.Args[2] == "merge-stacks" {
+			migration.InventoryMergeStacks(ctx, &cfg)
+			return
+		}
 		migration.InventoryMigrate(ctx, &cfg)
 	case "player":
 		migration.PlayerMigrate(ctx, &cfg)
@@ -0,0 +1,50 @@
+package gacha
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+)
+
+func NewServerSeed() string {
+	b := make([]byte, 32)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func HashSeed(seed string) string {
+	sum := sha256.Sum256([]byte(seed))
+	return hex.EncodeToString(sum[:])
+}
+
+// Roll = HMAC-SHA256(serverSeed, "clientSeed:nonce") mapped to [0, 1)
+func Roll(serverSeed, clientSeed string, nonce int64) float64 {
+	mac := hmac.New(sha256.New, []byte(serverSeed))
+	mac.Write([]byte(fmt.Sprintf("%s:%d", clientSeed, nonce)))
+	sum := mac.Sum(nil)
+
+	return float64(binary.BigEndian.Uint64(sum[:8])>>11) / float64(1<<53)
+}
+
+func Pick(weights []int, roll float64) int {
+	total := 0
+	for _, w := range weights {
+		total += w
+	}
+	if total <= 0 {
+		return -1
+	}
+
+	target := roll * float64(total)
+	acc := 0
+	for i, w := range weights {
+		acc += w
+		if target < float64(acc) {
+			return i
+		}
+	}
+	return len(weights) - 1
+}
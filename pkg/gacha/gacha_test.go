@@ -0,0 +1,64 @@
+package gacha
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type (
+	testRoll struct {
+		serverSeed string
+		clientSeed string
+		nonce      int64
+	}
+
+	testPick struct {
+		name     string
+		weights  []int
+		roll     float64
+		expected int
+	}
+)
+
+func TestRoll(t *testing.T) {
+	tests := []testRoll{
+		{serverSeed: "server", clientSeed: "client", nonce: 0},
+		{serverSeed: "server", clientSeed: "client", nonce: 1},
+		{serverSeed: "server", clientSeed: "other", nonce: 0},
+		{serverSeed: "", clientSeed: "", nonce: 0},
+		{serverSeed: NewServerSeed(), clientSeed: "player:001", nonce: 1 << 40},
+	}
+
+	seen := make(map[float64]bool)
+	for i, test := range tests {
+		t.Logf("case: %d", i+1)
+
+		roll := Roll(test.serverSeed, test.clientSeed, test.nonce)
+
+		assert.GreaterOrEqual(t, roll, 0.0)
+		assert.Less(t, roll, 1.0)
+		assert.Equal(t, roll, Roll(test.serverSeed, test.clientSeed, test.nonce))
+		assert.False(t, seen[roll])
+		seen[roll] = true
+	}
+}
+
+func TestPick(t *testing.T) {
+	tests := []testPick{
+		{name: "first bucket", weights: []int{1, 1}, roll: 0, expected: 0},
+		{name: "second bucket", weights: []int{1, 1}, roll: 0.5, expected: 1},
+		{name: "just below a boundary", weights: []int{1, 3}, roll: 0.2499, expected: 0},
+		{name: "on a boundary", weights: []int{1, 3}, roll: 0.25, expected: 1},
+		{name: "zero weight is skipped", weights: []int{0, 5, 0}, roll: 0.99, expected: 1},
+		{name: "roll close to one", weights: []int{2, 2, 2}, roll: 0.999999, expected: 2},
+		{name: "no weight", weights: []int{0, 0}, roll: 0.5, expected: -1},
+		{name: "no drops", weights: []int{}, roll: 0.5, expected: -1},
+	}
+
+	for _, test := range tests {
+		t.Logf("case: %s", test.name)
+
+		assert.Equal(t, test.expected, Pick(test.weights, test.roll))
+	}
+}
@@ -8,7 +8,9 @@ import (
 
 	"github.com/Applessr/hello-sekai-shop-tutorial/config"
 	authPb "github.com/Applessr/hello-sekai-shop-tutorial/modules/auth/authPb"
+	inventoryPb "github.com/Applessr/hello-sekai-shop-tutorial/modules/inventory/inventoryPb"
 	itemPb "github.com/Applessr/hello-sekai-shop-tutorial/modules/item/itemPb"
+	paymentPb "github.com/Applessr/hello-sekai-shop-tutorial/modules/payment/paymentPb"
 	playerPb "github.com/Applessr/hello-sekai-shop-tutorial/modules/player/playerPb"
 	jwtAuth "github.com/Applessr/hello-sekai-shop-tutorial/pkg/jwtauth"
 	"google.golang.org/grpc"
@@ -21,6 +23,8 @@ type (
 		Auth() authPb.AuthGrpcServiceClient
 		Item() itemPb.ItemGrpcServiceClient
 		Player() playerPb.PlayerGrpcServiceClient
+		Inventory() inventoryPb.InventoryGrpcServiceClient
+		Payment() paymentPb.PaymentGrpcServiceClient
 	}
 
 	grpcClientFactory struct {
@@ -32,34 +36,50 @@ type (
 	}
 )
 
-func (g *grpcAuth) unaryAuthorization(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+func (g *grpcAuth) authorize(ctx context.Context) error {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		log.Printf("Error: metadata not found")
-		return nil, errors.New("error: metadata not found")
+		return errors.New("error: metadata not found")
 	}
 
 	authHeader, ok := md["auth"]
 	if !ok {
 		log.Printf("Error: auth header not found")
-		return nil, errors.New("error: auth header not found")
+		return errors.New("error: auth header not found")
 	}
 
 	if len(authHeader) == 0 {
 		log.Printf("Error: auth header not found")
-		return nil, errors.New("error: auth header not found")
+		return errors.New("error: auth header not found")
 	}
 
 	claims, err := jwtAuth.ParseToken(g.secretKet, string(authHeader[0]))
 	if err != nil {
 		log.Printf("Error: Parse token failed: %s", err)
-		return nil, errors.New("error: parse token failed")
+		return errors.New("error: parse token failed")
 	}
 	log.Println("claims: ", claims)
 
+	return nil
+}
+
+func (g *grpcAuth) unaryAuthorization(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := g.authorize(ctx); err != nil {
+		return nil, err
+	}
+
 	return handler(ctx, req)
 }
 
+func (g *grpcAuth) streamAuthorization(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := g.authorize(ss.Context()); err != nil {
+		return err
+	}
+
+	return handler(srv, ss)
+}
+
 func (g *grpcClientFactory) Auth() authPb.AuthGrpcServiceClient {
 	return authPb.NewAuthGrpcServiceClient(g.client)
 }
@@ -72,6 +92,14 @@ func (g *grpcClientFactory) Player() playerPb.PlayerGrpcServiceClient {
 	return playerPb.NewPlayerGrpcServiceClient(g.client)
 }
 
+func (g *grpcClientFactory) Inventory() inventoryPb.InventoryGrpcServiceClient {
+	return inventoryPb.NewInventoryGrpcServiceClient(g.client)
+}
+
+func (g *grpcClientFactory) Payment() paymentPb.PaymentGrpcServiceClient {
+	return paymentPb.NewPaymentGrpcServiceClient(g.client)
+}
+
 func NewGrpcClient(host string) (GrpcClientFactoryHandler, error) {
 	opts := make([]grpc.DialOption, 0)
 
@@ -96,6 +124,7 @@ func NewGrpcServer(cfg *config.Jwt, host string) (*grpc.Server, net.Listener) {
 	}
 
 	opts = append(opts, grpc.UnaryInterceptor(grpcAuth.unaryAuthorization))
+	opts = append(opts, grpc.StreamInterceptor(grpcAuth.streamAuthorization))
 
 	grpcServer := grpc.NewServer(opts...)
 
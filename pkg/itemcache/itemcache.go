@@ -0,0 +1,153 @@
+package itemcache
+
+import (
+	"container/list"
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Applessr/hello-sekai-shop-tutorial/config"
+	itemPb "github.com/Applessr/hello-sekai-shop-tutorial/modules/item/itemPb"
+)
+
+type (
+	ItemCacheService interface {
+		FindItemInIds(pctx context.Context, req *itemPb.FindItemInIdsReq, fetch FetchFunc) (*itemPb.FindItemInIdsRes, error)
+		Put(items []*itemPb.Item)
+		Invalidate(itemId string)
+	}
+
+	FetchFunc func(pctx context.Context, req *itemPb.FindItemInIdsReq) (*itemPb.FindItemInIdsRes, error)
+
+	itemCache struct {
+		mu      sync.Mutex
+		ttl     time.Duration
+		size    int
+		entries map[string]*list.Element
+		lru     *list.List
+	}
+
+	entry struct {
+		key       string
+		item      *itemPb.Item
+		expiresAt time.Time
+	}
+)
+
+// NewItemCache returns nil when the cache is disabled so callers go straight to the item service
+func NewItemCache(cfg *config.ItemCache) ItemCacheService {
+	if cfg.Ttl <= 0 || cfg.Size <= 0 {
+		return nil
+	}
+	return &itemCache{
+		ttl:     time.Duration(cfg.Ttl) * time.Second,
+		size:    cfg.Size,
+		entries: make(map[string]*list.Element),
+		lru:     list.New(),
+	}
+}
+
+func key(itemId string) string {
+	return strings.TrimPrefix(itemId, "item:")
+}
+
+func (c *itemCache) get(itemId string) (*itemPb.Item, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key(itemId)]
+	if !ok {
+		return nil, false
+	}
+	e := el.Value.(*entry)
+	if time.Now().After(e.expiresAt) {
+		c.lru.Remove(el)
+		delete(c.entries, e.key)
+		return nil, false
+	}
+	c.lru.MoveToFront(el)
+	return e.item, true
+}
+
+func (c *itemCache) Put(items []*itemPb.Item) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expiresAt := time.Now().Add(c.ttl)
+	for _, item := range items {
+		k := key(item.Id)
+		if el, ok := c.entries[k]; ok {
+			el.Value = &entry{k, item, expiresAt}
+			c.lru.MoveToFront(el)
+			continue
+		}
+		c.entries[k] = c.lru.PushFront(&entry{k, item, expiresAt})
+
+		for c.lru.Len() > c.size {
+			oldest := c.lru.Back()
+			c.lru.Remove(oldest)
+			delete(c.entries, oldest.Value.(*entry).key)
+		}
+	}
+}
+
+func (c *itemCache) Invalidate(itemId string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if el, ok := c.entries[key(itemId)]; ok {
+		c.lru.Remove(el)
+		delete(c.entries, key(itemId))
+	}
+}
+
+// FindItemInIds answers from the cache and fetches only the missing ids, contents of cached bundles
+// are resolved too so the result matches the item service. Ids the item service doesn't return are
+// left out, an error means the item service could not be asked
+func (c *itemCache) FindItemInIds(pctx context.Context, req *itemPb.FindItemInIdsReq, fetch FetchFunc) (*itemPb.FindItemInIdsRes, error) {
+	items := make([]*itemPb.Item, 0)
+	seen := make(map[string]bool)
+	missing := make([]string, 0)
+
+	queue := append([]string{}, req.Ids...)
+	for len(queue) > 0 {
+		itemId := queue[0]
+		queue = queue[1:]
+		if seen[key(itemId)] {
+			continue
+		}
+		seen[key(itemId)] = true
+
+		item, ok := c.get(itemId)
+		if !ok {
+			missing = append(missing, itemId)
+			continue
+		}
+		items = append(items, item)
+		queue = append(queue, item.BundleItems...)
+	}
+
+	if len(missing) > 0 {
+		result, err := fetch(pctx, &itemPb.FindItemInIdsReq{Ids: missing})
+		// A failed fetch can't tell the missing ids from the ones it never reached, so it fails the
+		// whole lookup rather than pass the cached items off as everything that exists
+		if err != nil {
+			return nil, err
+		}
+		c.Put(result.Items)
+
+		found := make(map[string]bool)
+		for _, item := range items {
+			found[key(item.Id)] = true
+		}
+		for _, item := range result.Items {
+			if !found[key(item.Id)] {
+				found[key(item.Id)] = true
+				items = append(items, item)
+			}
+		}
+	}
+
+	return &itemPb.FindItemInIdsRes{Items: items}, nil
+}
@@ -0,0 +1,65 @@
+package itemcache
+
+import (
+	"log"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/Applessr/hello-sekai-shop-tutorial/config"
+	"github.com/Applessr/hello-sekai-shop-tutorial/modules/item"
+	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/queue"
+	"github.com/IBM/sarama"
+)
+
+// WatchItemEvents drops cached items as soon as the item service reports a change,
+// the cache lives in memory so only events from now on matter
+func WatchItemEvents(cfg *config.Config, cache ItemCacheService) {
+	worker, err := queue.ConnectConsumer([]string{cfg.Kafka.Url}, cfg.Kafka.ApiKey, cfg.Kafka.Secret)
+	if err != nil {
+		return
+	}
+	defer worker.Close()
+
+	partitions, err := worker.Partitions("item")
+	if err != nil {
+		log.Println("Error: WatchItemEvents failed: ", err.Error())
+		return
+	}
+
+	messages := make(chan *sarama.ConsumerMessage)
+	for _, partition := range partitions {
+		consumer, err := worker.ConsumePartition("item", partition, sarama.OffsetNewest)
+		if err != nil {
+			log.Println("Error: WatchItemEvents failed: ", err.Error())
+			return
+		}
+		defer consumer.Close()
+
+		go func() {
+			for msg := range consumer.Messages() {
+				messages <- msg
+			}
+		}()
+	}
+
+	log.Println("Start WatchItemEvents ...")
+
+	sigchan := make(chan os.Signal, 1)
+	signal.Notify(sigchan, syscall.SIGINT, syscall.SIGTERM)
+
+	for {
+		select {
+		case msg := <-messages:
+			req := new(item.ItemEvent)
+			if err := queue.DecodeMessage(req, msg.Value); err != nil {
+				continue
+			}
+			cache.Invalidate(req.ItemId)
+			log.Printf("WatchItemEvents | %s invalidated %s v%d", req.Type, req.ItemId, req.Version)
+		case <-sigchan:
+			log.Println("Stop WatchItemEvents...")
+			return
+		}
+	}
+}
@@ -1,24 +1,74 @@
 package server
 
 import (
+	"log"
+
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/inventory/inventoryHandler"
+	inventoryPb "github.com/Applessr/hello-sekai-shop-tutorial/modules/inventory/inventoryPb"
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/inventory/inventoryRepository"
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/inventory/inventoryUsecase"
+	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/grpccon"
+	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/itemcache"
 )
 
 func (s *server) inventoryService() {
-	repo := inventoryRepository.NewInventoryRepository(s.db)
+	itemCache := itemcache.NewItemCache(&s.cfg.ItemCache)
+	if itemCache != nil && s.cfg.ItemCache.Invalidate {
+		go itemcache.WatchItemEvents(s.cfg, itemCache)
+	}
+
+	repo := inventoryRepository.NewInventoryRepository(s.db, itemCache)
 	usecase := inventoryUsecase.NewInventoryUsecase(repo)
 	httpHandler := inventoryHandler.NewInventoryHttpHandler(s.cfg, usecase)
 	queueHandler := inventoryHandler.NewInventoryQueueHandler(s.cfg, usecase)
+	workerHandler := inventoryHandler.NewInventoryWorkerHandler(s.cfg, usecase)
+	grpcHandler := inventoryHandler.NewInventoryGrpcHandler(s.cfg, usecase)
+
+	go func() {
+		grpcServer, lis := grpccon.NewGrpcServer(&s.cfg.Jwt, s.cfg.Grpc.InventoryUrl)
+
+		inventoryPb.RegisterInventoryGrpcServiceServer(grpcServer, grpcHandler)
+
+		log.Printf("Inventory gRPC server listening on %s", s.cfg.Grpc.InventoryUrl)
+		grpcServer.Serve(lis)
+	}()
 
 	go queueHandler.AddPlayerItem()
 	go queueHandler.RollbackAddPlayerItem()
 	go queueHandler.RemovePlayerItem()
 	go queueHandler.RollbackRemovePlayerItem()
+	go queueHandler.SyncItemSnapshots()
+
+	go workerHandler.ExpireTrades()
+	go workerHandler.ExpireItems()
+	go workerHandler.RunAdminJobs()
+	go workerHandler.RecoverCrafts()
+	go workerHandler.RefreshSnapshots()
 
 	inventory := s.app.Group("/inventory_v1")
 
 	inventory.GET("", s.healthCheckService)
 	inventory.GET("/inventory/:player_id", httpHandler.FindPlayerItems, s.middleware.JwtAuthorization, s.middleware.PlayerIdParamValidation)
+	inventory.GET("/inventory/:player_id/capacity", httpHandler.FindPlayerCapacity, s.middleware.JwtAuthorization, s.middleware.PlayerIdParamValidation)
+	inventory.GET("/inventory/:player_id/equipment", httpHandler.FindLoadout, s.middleware.JwtAuthorization, s.middleware.PlayerIdParamValidation)
+	inventory.GET("/inventory/:player_id/history", s.middleware.JwtAuthorization(s.middleware.RbacAuthorization(httpHandler.FindPlayerItemHistory, []int{1, 0})))
+	inventory.GET("/inventory/:player_id/snapshot", s.middleware.JwtAuthorization(s.middleware.RbacAuthorization(httpHandler.RebuildPlayerInventory, []int{1, 0})))
+
+	inventory.GET("/admin/jobs/:job_id", s.middleware.JwtAuthorization(s.middleware.RbacAuthorization(httpHandler.FindAdminJob, []int{1, 0})))
+	inventory.POST("/admin/grant", s.middleware.JwtAuthorization(s.middleware.RbacAuthorization(httpHandler.AdminGrantItems, []int{1, 0})))
+	inventory.POST("/admin/revoke", s.middleware.JwtAuthorization(s.middleware.RbacAuthorization(httpHandler.AdminRevokeItems, []int{1, 0})))
+	inventory.POST("/admin/transfer", s.middleware.JwtAuthorization(s.middleware.RbacAuthorization(httpHandler.AdminTransferItem, []int{1, 0})))
+	inventory.POST("/admin/batch-grant", s.middleware.JwtAuthorization(s.middleware.RbacAuthorization(httpHandler.CreateBatchGrant, []int{1, 0})))
+
+	inventory.POST("/equipment/equip", httpHandler.EquipItem, s.middleware.JwtAuthorization)
+	inventory.POST("/equipment/unequip", httpHandler.UnequipItem, s.middleware.JwtAuthorization)
+
+	inventory.POST("/craft", httpHandler.CraftItem, s.middleware.JwtAuthorization)
+	inventory.POST("/upgrade", httpHandler.UpgradeItem, s.middleware.JwtAuthorization)
+
+	inventory.GET("/trade/:trade_id", httpHandler.FindOneTrade, s.middleware.JwtAuthorization)
+	inventory.POST("/trade", httpHandler.CreateTrade, s.middleware.JwtAuthorization)
+	inventory.PATCH("/trade/:trade_id/offer", httpHandler.UpdateTradeOffer, s.middleware.JwtAuthorization)
+	inventory.PATCH("/trade/:trade_id/confirm", httpHandler.ConfirmTrade, s.middleware.JwtAuthorization)
+	inventory.PATCH("/trade/:trade_id/cancel", httpHandler.CancelTrade, s.middleware.JwtAuthorization)
 }
@@ -7,14 +7,21 @@ import (
 	itemPb "github.com/Applessr/hello-sekai-shop-tutorial/modules/item/itemPb"
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/item/itemRepository"
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/item/itemUsecase"
+	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/blobstore"
 	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/grpccon"
 )
 
 func (s *server) itemService() {
 	repo := itemRepository.NewItemRepository(s.db)
-	usecase := itemUsecase.NewItemUsecase(repo)
+	blobStore, err := blobstore.NewBlobStore(&s.cfg.Blob)
+	if err != nil {
+		log.Fatalf("Error: %s", err.Error())
+	}
+	usecase := itemUsecase.NewItemUsecase(repo, blobStore)
 	httpHandler := itemHandler.NewItemHttpHandler(s.cfg, usecase)
 	grpcHandler := itemHandler.NewItemGrpcHandler(usecase)
+	queueHandler := itemHandler.NewItemQueueHandler(s.cfg, usecase)
+	workerHandler := itemHandler.NewItemWorkerHandler(s.cfg, usecase)
 
 	go func() {
 		grpcServer, lis := grpccon.NewGrpcServer(&s.cfg.Jwt, s.cfg.Grpc.ItemUrl)
@@ -25,16 +32,45 @@ func (s *server) itemService() {
 		grpcServer.Serve(lis)
 	}()
 
+	go queueHandler.WatchItemEvents()
+
+	go workerHandler.AnnouncePriceSchedules()
+
 	_ = grpcHandler
 
 	item := s.app.Group("/item_v1")
 
 	item.GET("", s.healthCheckService)
 	item.GET("/item", httpHandler.FindManyItem)
+	item.GET("/item/suggest", httpHandler.SuggestItems)
+	item.GET("/item/export", s.middleware.JwtAuthorization(s.middleware.RbacAuthorization(httpHandler.ExportItems, []int{1, 0})))
 	item.GET("/item/:item_id", httpHandler.FindOneItem)
+	item.GET("/item/:item_id/drop-rates", httpHandler.FindLootBoxDropRates)
+	item.GET("/item/:item_id/upgrades", httpHandler.FindUpgradePath)
+	item.GET("/images/*", httpHandler.FindImage)
+	item.GET("/recipe", httpHandler.FindRecipes)
+	item.GET("/recipe/:recipe_id", httpHandler.FindOneRecipe)
+	item.GET("/storefront", httpHandler.FindStorefront, s.middleware.JwtAuthorization)
+	item.GET("/storefront/rules", s.middleware.JwtAuthorization(s.middleware.RbacAuthorization(httpHandler.FindStorefrontRules, []int{1, 0})))
+
+	item.GET("/item/:item_id/history", s.middleware.JwtAuthorization(s.middleware.RbacAuthorization(httpHandler.FindItemHistory, []int{1, 0})))
+	item.GET("/item/:item_id/price-schedules", s.middleware.JwtAuthorization(s.middleware.RbacAuthorization(httpHandler.FindPriceSchedules, []int{1, 0})))
 
 	item.POST("/item", s.middleware.JwtAuthorization(s.middleware.RbacAuthorization(httpHandler.CreatedItem, []int{1, 0})))
+	item.POST("/item/image", s.middleware.JwtAuthorization(s.middleware.RbacAuthorization(httpHandler.UploadImage, []int{1, 0})))
+	item.POST("/item/:item_id/image", s.middleware.JwtAuthorization(s.middleware.RbacAuthorization(httpHandler.UploadImage, []int{1, 0})))
+	item.POST("/item/import", s.middleware.JwtAuthorization(s.middleware.RbacAuthorization(httpHandler.ImportItems, []int{1, 0})))
+	item.POST("/item/:item_id/history/:revision_id/revert", s.middleware.JwtAuthorization(s.middleware.RbacAuthorization(httpHandler.RevertItem, []int{1, 0})))
+	item.POST("/item/:item_id/price-schedules", s.middleware.JwtAuthorization(s.middleware.RbacAuthorization(httpHandler.CreatePriceSchedule, []int{1, 0})))
+	item.POST("/recipe", s.middleware.JwtAuthorization(s.middleware.RbacAuthorization(httpHandler.CreateRecipe, []int{1, 0})))
+	item.POST("/storefront/rules", s.middleware.JwtAuthorization(s.middleware.RbacAuthorization(httpHandler.CreateStorefrontRule, []int{1, 0})))
 
 	item.PATCH("/item/:item_id", s.middleware.JwtAuthorization(s.middleware.RbacAuthorization(httpHandler.EditItem, []int{1, 0})))
 	item.PATCH("/item/:item_id/is-activated", s.middleware.JwtAuthorization(s.middleware.RbacAuthorization(httpHandler.EnableOrDisableItem, []int{1, 0})))
+	item.PATCH("/item/:item_id/loot-box", s.middleware.JwtAuthorization(s.middleware.RbacAuthorization(httpHandler.UpdateLootBox, []int{1, 0})))
+	item.PATCH("/item/:item_id/upgrades", s.middleware.JwtAuthorization(s.middleware.RbacAuthorization(httpHandler.UpdateUpgrades, []int{1, 0})))
+	item.PATCH("/recipe/:recipe_id/is-activated", s.middleware.JwtAuthorization(s.middleware.RbacAuthorization(httpHandler.EnableOrDisableRecipe, []int{1, 0})))
+	item.PATCH("/storefront/rules/:rule_id/is-activated", s.middleware.JwtAuthorization(s.middleware.RbacAuthorization(httpHandler.EnableOrDisableStorefrontRule, []int{1, 0})))
+
+	item.DELETE("/item/:item_id/price-schedules/:schedule_id", s.middleware.JwtAuthorization(s.middleware.RbacAuthorization(httpHandler.DeletePriceSchedule, []int{1, 0})))
 }
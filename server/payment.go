@@ -1,23 +1,48 @@
 package server
 
 import (
+	"log"
+
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/payment/paymentHandler"
+	paymentPb "github.com/Applessr/hello-sekai-shop-tutorial/modules/payment/paymentPb"
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/payment/paymentRepository"
 	"github.com/Applessr/hello-sekai-shop-tutorial/modules/payment/paymentUsecase"
+	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/grpccon"
+	"github.com/Applessr/hello-sekai-shop-tutorial/pkg/itemcache"
 )
 
 func (s *server) paymentService() {
-	repo := paymentRepository.NewPaymentRepository(s.db)
+	itemCache := itemcache.NewItemCache(&s.cfg.ItemCache)
+	if itemCache != nil && s.cfg.ItemCache.Invalidate {
+		go itemcache.WatchItemEvents(s.cfg, itemCache)
+	}
+
+	repo := paymentRepository.NewPaymentRepository(s.db, itemCache)
 	usecase := paymentUsecase.NewPaymentUsecase(repo)
 	httpHandler := paymentHandler.NewPaymentHttpHandler(s.cfg, usecase)
+	grpcHandler := paymentHandler.NewPaymentGrpcHandler(s.cfg, usecase)
 	// queueHandler := paymentHandler.NewPaymentQueueHandler(s.cfg, usecase)
 
+	go func() {
+		grpcServer, lis := grpccon.NewGrpcServer(&s.cfg.Jwt, s.cfg.Grpc.PaymentUrl)
+
+		paymentPb.RegisterPaymentGrpcServiceServer(grpcServer, grpcHandler)
+
+		log.Printf("Payment gRPC server listening on %s", s.cfg.Grpc.PaymentUrl)
+		grpcServer.Serve(lis)
+	}()
+
 	_ = httpHandler
 
 	payment := s.app.Group("/payment_v1")
 
 	payment.GET("", s.healthCheckService)
 
+	payment.POST("/payment/quote", httpHandler.QuoteItems, s.middleware.JwtAuthorization)
 	payment.POST("/payment/buy", httpHandler.BuyItem, s.middleware.JwtAuthorization)
 	payment.POST("/payment/sell", httpHandler.SellItem, s.middleware.JwtAuthorization)
+
+	payment.GET("/loot-box/seed", httpHandler.GetLootBoxSeed, s.middleware.JwtAuthorization)
+	payment.POST("/loot-box/seed/rotate", httpHandler.RotateLootBoxSeed, s.middleware.JwtAuthorization)
+	payment.POST("/loot-box/open", httpHandler.OpenLootBox, s.middleware.JwtAuthorization)
 }
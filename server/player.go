@@ -34,9 +34,13 @@ func (s *server) playerService() {
 
 	player.GET("", s.healthCheckService)
 
-	player.GET("/player/:player_id", httpHandler.FindOnePlayerProfile)
+	// The full profile, email included, is only for the player themselves and admins
+	player.GET("/player/:player_id", s.middleware.PlayerOrRbacAuthorization(httpHandler.FindOnePlayerProfile, httpHandler.FindPlayerPublicProfile, []int{1, 0}), s.middleware.OptionalJwtAuthorization)
 	player.GET("/player/saving-account/my-account", httpHandler.GetPlayerSavingAccount, s.middleware.JwtAuthorization)
 
 	player.POST("/player/register", httpHandler.CreatePlayer)
 	player.POST("/player/add-money", httpHandler.AddPlayerMoney, s.middleware.JwtAuthorization)
+
+	player.PATCH("/player/privacy", httpHandler.UpdatePrivacy, s.middleware.JwtAuthorization)
+	player.PATCH("/player/showcase", httpHandler.UpdateShowcase, s.middleware.JwtAuthorization)
 }